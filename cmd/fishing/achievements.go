@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/fishing-game/game"
+)
+
+func (m model) updateAchievements(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc", "enter":
+		m.state = "menu"
+		updateCurrentUIState("menu")
+	}
+	return m, nil
+}
+
+func (m model) renderAchievements() string {
+	content := strings.Builder{}
+
+	achievements := game.GetAllAchievements()
+	content.WriteString(historyHeaderStyle.Render(fmt.Sprintf("ACHIEVEMENTS (%d/%d)",
+		len(player.Achievements), len(achievements))) + "\n\n")
+
+	barWidth := 20
+	if m.width < 60 {
+		barWidth = 10
+	}
+
+	now := time.Now()
+	for _, achievement := range achievements {
+		earned, unlocked := player.Achievements[achievement.ID]
+
+		if unlocked {
+			content.WriteString(successStyle.Render("🏆 "+achievement.Name) +
+				infoStyle.Render(" - earned "+earned.Format("Jan 2 2006")) + "\n")
+		} else {
+			content.WriteString(accentStyle.Render("   "+achievement.Name) + "\n")
+		}
+
+		if m.width >= 50 {
+			content.WriteString("   " + achievement.Description + "\n")
+		}
+
+		// Progress bar towards the goal
+		if !unlocked {
+			progress := achievement.Progress(&player, now)
+			target := achievement.Target()
+			blocks := 0
+			if target > 0 {
+				blocks = barWidth * progress / target
+			}
+			bar := "[" + strings.Repeat("█", blocks) + strings.Repeat("░", barWidth-blocks) + "]"
+			content.WriteString(infoStyle.Render(fmt.Sprintf("   %s %d/%d", bar, progress, target)) + "\n")
+		}
+	}
+
+	return boxStyle.Render(content.String())
+}
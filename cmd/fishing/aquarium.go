@@ -0,0 +1,309 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/fishing-game/game"
+)
+
+// Height of the water in the tank, in rows
+const tankRows = 6
+
+// aquariumTickMsg animates the fish swimming in the tank
+type aquariumTickMsg time.Time
+
+func aquariumTick() tea.Cmd {
+	return tea.Tick(time.Millisecond*200, func(t time.Time) tea.Msg {
+		return aquariumTickMsg(t)
+	})
+}
+
+// openAquarium switches to the aquarium screen and starts the animation
+func (m model) openAquarium() (tea.Model, tea.Cmd) {
+	m.state = "aquarium"
+	m.aquariumCursor = 0
+	m.aquariumPicking = false
+	m.message = ""
+
+	// Only one animation loop at a time, even when popping in and out quickly
+	if m.aquariumTicking {
+		return m, nil
+	}
+	m.aquariumTicking = true
+	return m, aquariumTick()
+}
+
+// aquariumCandidates returns the inventory positions of fish that can go in the tank
+func aquariumCandidates() []int {
+	candidates := []int{}
+	for i, fish := range player.FishCaught {
+		if !fish.IsTrash {
+			candidates = append(candidates, i)
+		}
+	}
+	return candidates
+}
+
+func (m model) updateAquarium(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	mu.Lock()
+	m, changed := m.handleAquariumKey(msg)
+	mu.Unlock()
+
+	// Save right away so the tank isn't lost
+	// (saveGameProgress takes the lock itself)
+	if changed {
+		saveGameProgress()
+	}
+	return m, nil
+}
+
+// handleAquariumKey handles a key on the aquarium screen. Returns whether
+// the tank or the inventory changed. Callers must hold mu.
+func (m model) handleAquariumKey(msg tea.KeyMsg) (model, bool) {
+	changed := false
+
+	// Choosing a fish from the inventory to put in the tank
+	if m.aquariumPicking {
+		candidates := aquariumCandidates()
+		switch msg.String() {
+		case "q", "esc":
+			m.aquariumPicking = false
+			m.aquariumCursor = 0
+		case "up", "k":
+			if m.aquariumCursor > 0 {
+				m.aquariumCursor--
+			}
+		case "down", "j":
+			if m.aquariumCursor < len(candidates)-1 {
+				m.aquariumCursor++
+			}
+		case "enter", " ":
+			if m.aquariumCursor >= len(candidates) {
+				return m, false
+			}
+			fish := player.FishCaught[candidates[m.aquariumCursor]]
+			if player.PlaceInAquarium(candidates[m.aquariumCursor]) {
+				changed = true
+				m.message = fmt.Sprintf("%s is now swimming in your %s.", fish.Name, player.Aquarium.Tank().Name)
+			} else {
+				m.message = "Your tank is full! Upgrade it to keep more fish."
+			}
+			m.aquariumPicking = false
+			m.aquariumCursor = len(player.Aquarium.Fish) - 1
+		}
+		return m, changed
+	}
+
+	switch msg.String() {
+	case "q", "esc":
+		m.state = "menu"
+		updateCurrentUIState("menu")
+	case "up", "k":
+		if m.aquariumCursor > 0 {
+			m.aquariumCursor--
+		}
+	case "down", "j":
+		if m.aquariumCursor < len(player.Aquarium.Fish)-1 {
+			m.aquariumCursor++
+		}
+	case "p":
+		if player.Aquarium.IsFull() {
+			m.message = "Your tank is full! Upgrade it to keep more fish."
+		} else if len(aquariumCandidates()) == 0 {
+			m.message = "You have no fish to put in the tank. Go catch some!"
+		} else {
+			m.aquariumPicking = true
+			m.aquariumCursor = 0
+			m.message = ""
+		}
+	case "t":
+		if m.aquariumCursor < len(player.Aquarium.Fish) {
+			fish := player.Aquarium.Fish[m.aquariumCursor]
+			changed = player.TakeFromAquarium(m.aquariumCursor)
+			m.message = fmt.Sprintf("%s went back to your inventory.", fish.Name)
+			if m.aquariumCursor >= len(player.Aquarium.Fish) && m.aquariumCursor > 0 {
+				m.aquariumCursor--
+			}
+		}
+	case "u":
+		next, ok := player.Aquarium.NextTank()
+		if !ok {
+			m.message = "You already have the biggest tank there is!"
+		} else if player.UpgradeTank() {
+			changed = true
+			m.message = fmt.Sprintf("Upgraded to a %s for $%d! It holds %d fish.", next.Name, next.Cost, next.Capacity)
+		} else {
+			m.message = fmt.Sprintf("A %s costs $%d. You need more money!", next.Name, next.Cost)
+		}
+	}
+	return m, changed
+}
+
+// mirrorFishShape flips a fish shape so it swims to the left
+func mirrorFishShape(shape string) string {
+	runes := []rune(shape)
+	mirrored := make([]rune, len(runes))
+	for i, r := range runes {
+		switch r {
+		case '<':
+			r = '>'
+		case '>':
+			r = '<'
+		case '(':
+			r = ')'
+		case ')':
+			r = '('
+		}
+		mirrored[len(runes)-1-i] = r
+	}
+	return string(mirrored)
+}
+
+// renderTank draws the tank with its fish swimming back and forth
+func renderTank(fishList []game.Fish, decorations []game.Recipe, frame int, width int) string {
+	water := lipgloss.NewStyle().Foreground(lipgloss.Color("#61AFEF"))
+
+	// Each row of water holds the fish swimming in it
+	type swimmer struct {
+		pos   int
+		shape string
+	}
+	rows := make([][]swimmer, tankRows)
+
+	for i, fish := range fishList {
+		shape := generateFishPattern(fish)
+		span := width - lipgloss.Width(shape)
+		if span <= 0 {
+			continue
+		}
+
+		// Swim to the right wall and back, each fish at its own speed and start
+		speed := 1 + i%3
+		travel := (frame*speed + i*7) % (2 * span)
+		pos := travel
+		if travel >= span {
+			pos = 2*span - travel
+			shape = fishColorStyle(fish).Render(mirrorFishShape(fishShape(fish)))
+		}
+
+		rows[i%tankRows] = append(rows[i%tankRows], swimmer{pos, shape})
+	}
+
+	tank := strings.Builder{}
+	tank.WriteString(water.Render("┌"+strings.Repeat("~", width)+"┐") + "\n")
+	for r, row := range rows {
+		line := strings.Builder{}
+		column := 0
+
+		// Draw swimmers left to right, letting the first one win where they overlap
+		for len(row) > 0 {
+			next := 0
+			for j := range row {
+				if row[j].pos < row[next].pos {
+					next = j
+				}
+			}
+			s := row[next]
+			row = append(row[:next], row[next+1:]...)
+			if s.pos < column {
+				continue
+			}
+
+			line.WriteString(strings.Repeat(" ", s.pos-column))
+			line.WriteString(s.shape)
+			column = s.pos + lipgloss.Width(s.shape)
+		}
+
+		// Bubbles rise up the side of the tank
+		fill := width - column
+		if fill > 0 {
+			padding := []rune(strings.Repeat(" ", fill))
+			if (frame/2+r)%tankRows == 0 {
+				padding[fill-1] = '°'
+			}
+			line.WriteString(water.Render(string(padding)))
+		}
+
+		tank.WriteString(water.Render("│") + line.String() + water.Render("│") + "\n")
+	}
+	// Crafted decorations sit on the gravel, spread out along the floor
+	floor := []rune(strings.Repeat("▓", width))
+	for i, decoration := range decorations {
+		pos := (i + 1) * width / (len(decorations) + 1)
+		floor[pos] = []rune(decoration.Icon)[0]
+	}
+	tank.WriteString(water.Render("└" + string(floor) + "┘"))
+
+	return tank.String()
+}
+
+func (m model) renderAquarium() string {
+	content := strings.Builder{}
+
+	tank := player.Aquarium.Tank()
+	content.WriteString(historyHeaderStyle.Render(fmt.Sprintf("AQUARIUM - %s (%d/%d)",
+		strings.ToUpper(tank.Name), len(player.Aquarium.Fish), tank.Capacity)) + "\n\n")
+
+	// Choosing a fish from the inventory
+	if m.aquariumPicking {
+		content.WriteString(accentStyle.Render("Choose a fish to put in the tank:") + "\n\n")
+
+		// Show a page of the inventory around the cursor
+		candidates := aquariumCandidates()
+		start := m.aquariumCursor - m.itemsPerPage/2
+		if start > len(candidates)-m.itemsPerPage {
+			start = len(candidates) - m.itemsPerPage
+		}
+		if start < 0 {
+			start = 0
+		}
+		end := start + m.itemsPerPage
+		if end > len(candidates) {
+			end = len(candidates)
+		}
+
+		for i := start; i < end; i++ {
+			fish := player.FishCaught[candidates[i]]
+			line := fmt.Sprintf("%s (%d lbs, $%d)", fish.Name, fish.Weight, fish.Value)
+			if i == m.aquariumCursor {
+				content.WriteString(highlightedMenuItemStyle.Render("> "+line) + " " + generateFishPattern(fish) + "\n")
+			} else {
+				content.WriteString("  " + line + " " + generateFishPattern(fish) + "\n")
+			}
+		}
+		content.WriteString("\n" + infoStyle.Render(fmt.Sprintf("%d of %d fish", m.aquariumCursor+1, len(candidates))))
+		return boxStyle.Render(content.String())
+	}
+
+	tankWidth := m.width - 12
+	if tankWidth > 60 {
+		tankWidth = 60
+	}
+	if tankWidth < 20 {
+		tankWidth = 20
+	}
+	content.WriteString(renderTank(player.Aquarium.Fish, player.Decorations(), m.aquariumFrame, tankWidth) + "\n\n")
+
+	if len(player.Aquarium.Fish) == 0 {
+		content.WriteString(infoStyle.Render("The tank is empty. Press p to add a fish from your inventory.") + "\n")
+	}
+	for i, fish := range player.Aquarium.Fish {
+		line := fmt.Sprintf("%s (%d lbs)", fish.Name, fish.Weight)
+		if i == m.aquariumCursor {
+			content.WriteString(highlightedMenuItemStyle.Render("> "+line) + "\n")
+		} else {
+			content.WriteString("  " + line + "\n")
+		}
+	}
+
+	if next, ok := player.Aquarium.NextTank(); ok {
+		content.WriteString("\n" + infoStyle.Render(fmt.Sprintf("Upgrade: %s holds %d fish for $%d",
+			next.Name, next.Capacity, next.Cost)))
+	}
+
+	return boxStyle.Render(content.String())
+}
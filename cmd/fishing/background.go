@@ -24,54 +24,15 @@ func idleRoutine() {
 }
 
 func autoFishingRoutine() {
-	// Auto-fishing timer (initial value)
-	duration := getRandomFishingDuration()
-	ticker := time.NewTicker(duration)
+	// Every line keeps its own bite timer, so check them all each second
+	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-ticker.C:
 			if autoFishing {
-				// Only catch fish in the background if we're not currently showing fishing in the UI
-				// Check the current state without locking since this is just a rough check
-				if currentUIState != "fishing" && currentUIState != "fishResult" {
-					mu.Lock()
-					// Calculate catch chance with weather factor
-					catchChance := (rand.Float64() * 10) + float64(player.RodStrength) + float64(player.BaitStrength)
-					catchChance *= weatherFactor
-
-					if catchChance >= 5 {
-						// Choose a fish based on rarity
-						totalRarity := 0
-						for _, fish := range availableFish {
-							totalRarity += fish.Rarity
-						}
-
-						randomNum := rand.Intn(totalRarity)
-
-						currentSum := 0
-						var chosenFish game.Fish
-						for _, fish := range availableFish {
-							currentSum += fish.Rarity
-							if randomNum < currentSum {
-								chosenFish = fish
-								break
-							}
-						}
-
-						if (chosenFish != game.Fish{}) {
-							player.AddFish(chosenFish)
-							// Auto-save when a fish is caught in background
-							saveGameProgress()
-						}
-					}
-					mu.Unlock()
-				}
-
-				// Set a new random fishing duration
-				newDuration := getRandomFishingDuration()
-				ticker.Reset(newDuration)
+				tendLines()
 			}
 		case <-stopIdle:
 			return
@@ -81,17 +42,22 @@ func autoFishingRoutine() {
 
 func processCatchesWhileAway() {
 	mu.Lock()
-	defer mu.Unlock()
 
 	now := time.Now()
 	minutesAway := now.Sub(lastActiveTime).Minutes()
 
+	// The ponds keep breeding whether or not anything is biting
+	tendFarm()
+
 	if minutesAway < 1 {
+		mu.Unlock()
 		return
 	}
 
-	// Calculate how many fish were caught while away
-	catchChance := idleCatchRate * minutesAway * weatherFactor
+	// Calculate how many fish were caught while away, on every line out
+	catchChance := idleCatchRate * (1 + player.IdleCatchBonus()) * minutesAway * weatherFactor
+	catchChance *= float64(player.LineCount())
+	catchChance *= eventCatchFactor()
 	wholeCatches := int(catchChance)
 
 	// Chance for an additional catch
@@ -101,20 +67,37 @@ func processCatchesWhileAway() {
 	}
 
 	// Process the catches - simplified to avoid potential issues
+	landedCount := 0
 	for i := 0; i < wholeCatches; i++ {
-		// Choose a random fish directly to avoid complexity
-		if len(availableFish) > 0 {
-			randomIndex := rand.Intn(len(availableFish))
-			player.AddFish(availableFish[randomIndex])
+		// Nothing more gets landed once the rod gives out
+		if player.IsRodBroken() {
+			break
+		}
+
+		// Choose a random fish directly to avoid complexity, from the
+		// waters the boat can reach. Legendary creatures have to be fought
+		// by hand, so they never bite while the player is away
+		tier := player.LaunchBoat()
+		reachable := withoutLegendary(withinReach(availableFish, tier))
+		reachable = append(reachable, festivalSpecies(tier)...)
+		if len(reachable) > 0 {
+			randomIndex := rand.Intn(len(reachable))
+			fish := game.RollCatch(reachable[randomIndex]).CaughtAt(player.Location(), now)
+			landed, loot := landFish(fish)
+			if landed {
+				landedCount++
+				announceLoot(fish.Name, loot)
+			}
 		}
 	}
 
+	lastActiveTime = now
+	mu.Unlock()
+
 	// Auto-save after processing idle catches
-	if wholeCatches > 0 {
+	if landedCount > 0 {
 		saveGameProgress()
 	}
-
-	lastActiveTime = now
 }
 
 func updateWeatherFactor() {
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/fishing-game/game"
+)
+
+// bossTickMsg drives the creature's moves during an encounter
+type bossTickMsg time.Time
+
+func bossTick() tea.Cmd {
+	return tea.Tick(time.Millisecond*100, func(t time.Time) tea.Msg {
+		return bossTickMsg(t)
+	})
+}
+
+// bossCooldown returns how long an escaped creature stays away
+func bossCooldown() time.Duration {
+	if testMode {
+		return 2 * time.Minute
+	}
+	return 6 * time.Hour
+}
+
+// withoutEscaped drops the legendary creatures that got away recently.
+// Callers must hold mu.
+func withoutEscaped(fishList []game.Fish) []game.Fish {
+	now := time.Now()
+	available := []game.Fish{}
+	for _, fish := range fishList {
+		if !fish.IsLegendary || player.BossAvailable(fish.Name, now) {
+			available = append(available, fish)
+		}
+	}
+	return available
+}
+
+// withoutLegendary drops the legendary creatures, for lines nobody is
+// holding to fight them
+func withoutLegendary(fishList []game.Fish) []game.Fish {
+	regular := []game.Fish{}
+	for _, fish := range fishList {
+		if !fish.IsLegendary {
+			regular = append(regular, fish)
+		}
+	}
+	return regular
+}
+
+// startEncounter begins the fight with a hooked legendary creature
+func (m model) startEncounter(fish game.Fish) (tea.Model, tea.Cmd) {
+	m.encounter = game.NewEncounter(fish, time.Now())
+	m.state = "boss"
+	m.message = ""
+	updateCurrentUIState("boss")
+	return m, bossTick()
+}
+
+// reelPower returns how much stamina each turn of the reel takes out of a creature
+func reelPower() int {
+	return 6 + 2*player.RodStrength
+}
+
+// endEncounter lands a creature that was worn out, or lets one go that broke free
+func (m model) endEncounter() (tea.Model, tea.Cmd) {
+	fish := m.encounter.Fish
+
+	if m.encounter.Lost() {
+		mu.Lock()
+		player.BossEscaped(fish.Name, time.Now().Add(bossCooldown()))
+		player.WearRod(fish.Weight)
+		player.BreakCombo()
+		mu.Unlock()
+		saveGameProgress()
+		return m, nil
+	}
+
+	// The fight is won, so there's no chance of the line snapping now
+	mu.Lock()
+	levelBefore := player.Level()
+	player.WearRod(fish.Weight)
+	fish = player.WithCombo(fish).CaughtAt(player.Location(), time.Now())
+	loot := keepCatch(fish)
+	player.ExtendCombo(fish)
+	player.AddXP(player.ComboXP(fish))
+	if tournament != nil {
+		tournament.AddPlayerCatch(fish, time.Now())
+	}
+	leveledUp := player.Level() > levelBefore
+	mu.Unlock()
+	saveGameProgress()
+
+	m.encounter = nil
+	return m, func() tea.Msg {
+		return catchResultMsg{
+			success:   true,
+			fish:      fish,
+			leveledUp: leveledUp,
+			loot:      loot,
+		}
+	}
+}
+
+func (m model) updateBoss(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.encounter == nil {
+		m.state = "menu"
+		updateCurrentUIState("menu")
+		return m, nil
+	}
+
+	// Once the creature has escaped, any key goes on
+	if m.encounter.IsOver() {
+		m.encounter = nil
+		if tournament != nil && tournament.IsOver(time.Now()) {
+			return m.finishTournament()
+		}
+		if (autoFishing || tournament != nil) && !player.IsRodBroken() {
+			return m.startCast()
+		}
+		m.state = "menu"
+		updateCurrentUIState("menu")
+		return m, nil
+	}
+
+	now := time.Now()
+	switch msg.String() {
+	case " ", "enter":
+		m.encounter.Reel(reelPower(), now)
+	case "q", "esc":
+		// Cut the line and let it go
+		m.encounter.Line = 0
+		m.encounter.Log = fmt.Sprintf("You cut the line. The %s disappears into the depths.", m.encounter.Fish.Name)
+	default:
+		m.encounter.Counter(msg.String(), now)
+	}
+
+	if m.encounter.IsOver() {
+		return m.endEncounter()
+	}
+	return m, nil
+}
+
+// renderMeter draws a bar showing how much of something is left
+func renderMeter(label string, value, full, width int, color string) string {
+	blocks := width * value / full
+	bar := strings.Repeat("█", blocks) + strings.Repeat("░", width-blocks)
+	return fmt.Sprintf("%-8s %s %d/%d", label, lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(bar), value, full)
+}
+
+func (m model) renderBoss() string {
+	content := strings.Builder{}
+	e := m.encounter
+	if e == nil {
+		return boxStyle.Render("")
+	}
+
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#FFFF00")).
+		Background(lipgloss.Color("#FF00FF")).
+		Padding(0, 2).
+		Render(fmt.Sprintf("⚔ %s ⚔", strings.ToUpper(e.Fish.Name)))
+	content.WriteString(header + "\n")
+	content.WriteString(infoStyle.Render(fmt.Sprintf("Phase %d of %d", e.Phase+1, game.BossPhases)) + "\n")
+
+	// The creature itself, shaking when it's making a move
+	if m.width >= 40 {
+		art := e.Boss.Art
+		if e.Move != nil && m.bossFrame%2 == 0 {
+			art = strings.ReplaceAll(art, "\n", "\n ")
+		}
+		content.WriteString(fishColorStyle(e.Fish).Render(art) + "\n")
+
+		// Choppy water under it
+		waves := []rune(strings.Repeat("~^", 20))
+		offset := m.bossFrame % 2
+		content.WriteString(infoStyle.Render(string(waves[offset:offset+36])) + "\n\n")
+	}
+
+	barWidth := 24
+	if m.width < 60 {
+		barWidth = 12
+	}
+	content.WriteString(renderMeter("Stamina", e.Stamina, e.Boss.Stamina, barWidth, "#E06C75") + "\n")
+	content.WriteString(renderMeter("Line", e.Line, game.FullLine, barWidth, "#98C379") + "\n\n")
+
+	switch {
+	case e.Won():
+		content.WriteString(successStyle.Render(fmt.Sprintf("The %s is exhausted! You haul it in!", e.Fish.Name)) + "\n")
+	case e.Lost():
+		content.WriteString(errorStyle.Render(e.Log) + "\n")
+		content.WriteString(errorStyle.Render(fmt.Sprintf("The %s got away! It won't be seen again for %s.",
+			e.Fish.Name, formatTimeLeft(bossCooldown()))) + "\n")
+	case e.Move != nil:
+		// The move being made and how long is left to counter it
+		left := time.Until(e.MoveEnds)
+		if left < 0 {
+			left = 0
+		}
+		content.WriteString(errorStyle.Render(e.Log) + "\n")
+		content.WriteString(accentStyle.Render(fmt.Sprintf("Press %s to %s! (%.1fs)",
+			e.Move.Key, strings.ToLower(e.Move.Action), left.Seconds())) + "\n")
+	default:
+		content.WriteString(e.Log + "\n")
+	}
+
+	return boxStyle.Render(content.String())
+}
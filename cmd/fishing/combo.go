@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/fishing-game/game"
+)
+
+// comboTickMsg animates the combo counter on the result screen
+type comboTickMsg time.Time
+
+func comboTick() tea.Cmd {
+	return tea.Tick(time.Millisecond*120, func(t time.Time) tea.Msg {
+		return comboTickMsg(t)
+	})
+}
+
+// Frames the combo counter pops for after a catch
+const comboFrames = 10
+
+// The counter flickers through these like a flame
+var comboColors = []string{"#FF5555", "#FF8844", "#FFAA00", "#FFDD55", "#FFAA00", "#FF8844"}
+
+// renderCombo draws the combo counter at an animation frame. It pops for
+// the first few frames and flickers after that. Nothing is drawn until
+// there are two catches in a row.
+func renderCombo(combo game.Combo, frame int) string {
+	if combo.Count < 2 {
+		return ""
+	}
+
+	// More flames for a longer combo
+	flames := combo.Count / 3
+	if flames < 1 {
+		flames = 1
+	} else if flames > 5 {
+		flames = 5
+	}
+
+	text := fmt.Sprintf("%s COMBO x%d  (x%.2f value & XP)", strings.Repeat("🔥", flames), combo.Count, combo.Multiplier())
+	if combo.School > 1 {
+		text += fmt.Sprintf(" | %s school x%d", combo.Species, combo.School)
+	}
+
+	style := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(comboColors[frame%len(comboColors)]))
+	if frame < comboFrames && frame%2 == 0 {
+		style = style.Background(lipgloss.Color("#553300"))
+	}
+	return style.Render(text)
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/fishing-game/game"
+)
+
+func (m model) updateCrafting(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	recipes := game.GetAllRecipes()
+
+	switch msg.String() {
+	case "q", "esc":
+		m.state = "menu"
+		updateCurrentUIState("menu")
+	case "up", "k":
+		if m.craftCursor > 0 {
+			m.craftCursor--
+		}
+	case "down", "j":
+		if m.craftCursor < len(recipes)-1 {
+			m.craftCursor++
+		}
+	case "enter", " ":
+		recipe := recipes[m.craftCursor]
+		mu.Lock()
+		crafted := player.Craft(recipe)
+		mu.Unlock()
+
+		if !crafted {
+			m.message = fmt.Sprintf("You don't have everything you need for a %s.", recipe.Name)
+			return m, nil
+		}
+		if recipe.Kind == "bait" {
+			m.message = fmt.Sprintf("Crafted a %s: +%d %s!", recipe.Name, recipe.Quantity, recipe.Output)
+		} else {
+			m.message = fmt.Sprintf("Crafted a %s!", recipe.Name)
+		}
+		saveGameProgress()
+	case "u":
+		message, used := useCraftedItem(recipes[m.craftCursor])
+		m.message = message
+		if used {
+			saveGameProgress()
+		}
+	}
+	return m, nil
+}
+
+// useCraftedItem uses a crafted item and describes what happened. Returns
+// whether the item was used.
+func useCraftedItem(recipe game.Recipe) (string, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if player.Crafted[recipe.Name] == 0 {
+		return fmt.Sprintf("You don't have a %s.", recipe.Name), false
+	}
+
+	switch recipe.Kind {
+	case "decoration":
+		return fmt.Sprintf("Your %s is already on show in the aquarium.", recipe.Name), false
+	case "gear":
+		if rod := game.GetRodByName(recipe.Output); player.Rod().Strength > rod.Strength {
+			return fmt.Sprintf("Your %s is better than a %s!", player.FishingRod, rod.Name), false
+		}
+	case "consumable":
+		if recipe.Name == "Rod Repair Kit" && player.RodWear == 0 {
+			return "Your rod doesn't need repairing.", false
+		}
+	}
+
+	if !player.UseCrafted(recipe.Name) {
+		return fmt.Sprintf("You can't use a %s.", recipe.Name), false
+	}
+	if recipe.Kind == "gear" {
+		return fmt.Sprintf("You're now fishing with your %s (a %s).", recipe.Name, recipe.Output), true
+	}
+	return fmt.Sprintf("Used a %s.", recipe.Name), true
+}
+
+func (m model) renderCrafting() string {
+	content := strings.Builder{}
+
+	content.WriteString(historyHeaderStyle.Render("CRAFTING") + "\n\n")
+
+	for i, recipe := range game.GetAllRecipes() {
+		canCraft := player.HasIngredients(recipe.Ingredients)
+
+		title := fmt.Sprintf("%s [%s]", recipe.Name, recipe.Kind)
+		if owned := player.Crafted[recipe.Name]; owned > 0 {
+			title += fmt.Sprintf(" - own %d", owned)
+		}
+
+		if i == m.craftCursor {
+			content.WriteString(highlightedMenuItemStyle.Render("> "+title) + "\n")
+		} else if canCraft {
+			content.WriteString(successStyle.Render("  "+title) + "\n")
+		} else {
+			content.WriteString("  " + title + "\n")
+		}
+
+		// What goes into it, and how much of it we have
+		parts := []string{}
+		for _, ingredient := range recipe.Ingredients {
+			parts = append(parts, fmt.Sprintf("%d/%d %s", player.CountFish(ingredient.Name), ingredient.Count, ingredient.Name))
+		}
+		needs := "    " + strings.Join(parts, ", ")
+		if canCraft {
+			content.WriteString(successStyle.Render(needs) + "\n")
+		} else {
+			content.WriteString(infoStyle.Render(needs) + "\n")
+		}
+
+		if i == m.craftCursor && m.width >= 50 {
+			content.WriteString("    " + recipe.Description + "\n")
+		}
+	}
+
+	return boxStyle.Render(content.String())
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/fishing-game/game"
+)
+
+// fishingGround is a location the player can fish from and the habitat tier it reaches
+type fishingGround struct {
+	Location string
+	Tier     int
+}
+
+// getFishingGrounds returns the shore and every place a boat goes, nearest first
+func getFishingGrounds() []fishingGround {
+	grounds := []fishingGround{{game.ShoreLocation, 0}}
+	for _, boat := range game.GetAllBoats() {
+		grounds = append(grounds, fishingGround{boat.Location, boat.Tier})
+	}
+	return grounds
+}
+
+// openPopulations shows the stocks wherever the player is fishing from
+func (m model) openPopulations() (tea.Model, tea.Cmd) {
+	m.state = "populations"
+	m.message = ""
+	m.populationPage = 0
+	m.groundCursor = 0
+	for i, ground := range getFishingGrounds() {
+		if ground.Location == player.Location() {
+			m.groundCursor = i
+		}
+	}
+	updateCurrentUIState("populations")
+	return m, nil
+}
+
+// populationPages returns how many pages the stocks of the selected ground take up
+func (m model) populationPages() int {
+	ground := getFishingGrounds()[m.groundCursor]
+	species := withinReach(game.GetRegularFish(), ground.Tier)
+	return (len(species) + m.itemsPerPage - 1) / m.itemsPerPage
+}
+
+func (m model) updatePopulations(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc":
+		m.state = "menu"
+		updateCurrentUIState("menu")
+	case "left", "h":
+		if m.groundCursor > 0 {
+			m.groundCursor--
+			m.populationPage = 0
+		}
+	case "right", "l":
+		if m.groundCursor < len(getFishingGrounds())-1 {
+			m.groundCursor++
+			m.populationPage = 0
+		}
+	case "down", "j":
+		if m.populationPage < m.populationPages()-1 {
+			m.populationPage++
+		}
+	case "up", "k":
+		if m.populationPage > 0 {
+			m.populationPage--
+		}
+	}
+	return m, nil
+}
+
+// populationStyle colors a stock by how healthy it is
+func populationStyle(level float64) string {
+	switch game.PopulationHealth(level) {
+	case "Healthy":
+		return successStyle.Render("Healthy")
+	case "Stressed":
+		return accentStyle.Render("Stressed")
+	case "Overfished":
+		return errorStyle.Render("Overfished")
+	default:
+		return errorStyle.Render("Collapsed")
+	}
+}
+
+func (m model) renderPopulations() string {
+	content := strings.Builder{}
+	now := time.Now()
+
+	grounds := getFishingGrounds()
+	ground := grounds[m.groundCursor]
+
+	content.WriteString(historyHeaderStyle.Render("FISH POPULATIONS") + "\n\n")
+
+	// Tabs for every fishing ground
+	tabs := []string{}
+	for i, g := range grounds {
+		if i == m.groundCursor {
+			tabs = append(tabs, highlightedMenuItemStyle.Render(g.Location))
+		} else {
+			tabs = append(tabs, g.Location)
+		}
+	}
+	content.WriteString(strings.Join(tabs, "  ") + "\n")
+	if ground.Location == player.Location() {
+		content.WriteString(infoStyle.Render("You're fishing here") + "\n")
+	}
+	content.WriteString("\n")
+
+	// Every species found here, the most depleted first
+	species := withinReach(game.GetRegularFish(), ground.Tier)
+	levels := map[string]float64{}
+	for _, fish := range species {
+		levels[fish.Name] = player.PopulationLevel(ground.Location, fish, now)
+	}
+	sort.SliceStable(species, func(i, j int) bool {
+		return levels[species[i].Name] < levels[species[j].Name]
+	})
+
+	// Page through the list
+	totalPages := m.populationPages()
+	page := m.populationPage
+	if page >= totalPages {
+		page = totalPages - 1
+	}
+	start := page * m.itemsPerPage
+	end := start + m.itemsPerPage
+	if end > len(species) {
+		end = len(species)
+	}
+
+	barWidth := 20
+	if m.width < 60 {
+		barWidth = 10
+	}
+	for _, fish := range species[start:end] {
+		level := levels[fish.Name]
+		blocks := int(float64(barWidth)*level + 0.5)
+		bar := "[" + strings.Repeat("█", blocks) + strings.Repeat("░", barWidth-blocks) + "]"
+		content.WriteString(fmt.Sprintf("%-16.16s %s %3d%% %s\n", fish.Name, bar, int(level*100+0.5), populationStyle(level)))
+	}
+
+	content.WriteString("\n" + infoStyle.Render(fmt.Sprintf("Page %d/%d | Stocks recover over time, even while you're away", page+1, totalPages)))
+
+	return boxStyle.Render(content.String())
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/user/fishing-game/game"
+)
+
+// currentEvents returns the world events going on right now
+func currentEvents() []game.ActiveEvent {
+	return game.CurrentEvents(worldEvents, time.Now())
+}
+
+// updateWorldEvents gives the random events their chance to start over the
+// given number of hours, and notes every running event in today's log with
+// a banner for the ones that just began. Returns whether anything changed.
+// Callers must hold mu.
+func updateWorldEvents(hours float64) bool {
+	now := time.Now()
+
+	// Events come round far more often when testing
+	if testMode {
+		hours *= 60
+	}
+
+	// Forget the events that have ended and roll for new ones
+	running := []game.ActiveEvent{}
+	for _, occurrence := range worldEvents {
+		if occurrence.IsRunning(now) {
+			running = append(running, occurrence)
+		}
+	}
+	worldEvents = append(running, game.RollEvents(currentEvents(), hours, now)...)
+
+	return noteEvents(currentEvents())
+}
+
+// noteEvents records events in today's log so the history shows what was
+// going on, and announces the ones that weren't noted yet.
+// Callers must hold mu.
+func noteEvents(events []game.ActiveEvent) bool {
+	today := time.Now().Format("2006-01-02")
+	changed := false
+
+	for _, occurrence := range events {
+		noted := false
+		for _, logged := range dailyEvents[today] {
+			if logged.Name == occurrence.Name && logged.Started.Equal(occurrence.Started) {
+				noted = true
+				break
+			}
+		}
+		if noted {
+			continue
+		}
+
+		dailyEvents[today] = append(dailyEvents[today], occurrence)
+		showBanner(fmt.Sprintf("%s %s: %s", occurrence.Event().Icon, occurrence.Name, occurrence.Description))
+		changed = true
+	}
+	return changed
+}
+
+// startEventRoutine checks on the world events every minute
+func startEventRoutine() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			mu.Lock()
+			changed := updateWorldEvents(1.0 / 60)
+			mu.Unlock()
+
+			if changed {
+				saveGameProgress()
+			}
+		case <-stopIdle:
+			return
+		}
+	}
+}
+
+// eventCatchFactor returns the multiplier the world events put on the chance of a bite
+func eventCatchFactor() float64 {
+	factor := 1.0
+	for _, occurrence := range currentEvents() {
+		if event := occurrence.Event(); event.CatchFactor > 0 {
+			factor *= event.CatchFactor
+		}
+	}
+	return factor
+}
+
+// eventPriceFactor returns the multiplier the world events put on what fish sell for
+func eventPriceFactor() float64 {
+	factor := 1.0
+	for _, occurrence := range currentEvents() {
+		if event := occurrence.Event(); event.PriceFactor > 0 {
+			factor *= event.PriceFactor
+		}
+	}
+	return factor
+}
+
+// eventTrashBonus returns the extra chance of hooking trash during the world events
+func eventTrashBonus() float64 {
+	bonus := 0.0
+	for _, occurrence := range currentEvents() {
+		bonus += occurrence.Event().TrashBonus
+	}
+	return bonus
+}
+
+// eventLegendaryBonus returns the extra chance of hooking a legendary creature
+// during the world events
+func eventLegendaryBonus() float64 {
+	bonus := 0.0
+	for _, occurrence := range currentEvents() {
+		bonus += occurrence.Event().LegendaryBonus
+	}
+	return bonus
+}
+
+// eventSpecies returns how much the world events add to the rarity weight of
+// each species they draw in or drive off
+func eventSpecies() map[string]int {
+	species := map[string]int{}
+	for _, occurrence := range currentEvents() {
+		for name, weight := range occurrence.Event().Species {
+			species[name] += weight
+		}
+	}
+	return species
+}
+
+// getEventsOnDate returns the world events that were going on on a specific date
+func getEventsOnDate(date string) []game.ActiveEvent {
+	return dailyEvents[date]
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/fishing-game/game"
+)
+
+// Custom message type to refresh the expedition timer and bring the boat home
+type expeditionTickMsg time.Time
+
+func expeditionTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return expeditionTickMsg(t)
+	})
+}
+
+// expeditionDurations returns how long an expedition can be sent out for
+func expeditionDurations() []time.Duration {
+	if testMode {
+		return []time.Duration{2 * time.Minute, 5 * time.Minute, 10 * time.Minute}
+	}
+	return []time.Duration{1 * time.Hour, 4 * time.Hour, 8 * time.Hour}
+}
+
+// expeditionCastInterval returns how often the crew puts out a line
+func expeditionCastInterval() time.Duration {
+	if testMode {
+		return 10 * time.Second
+	}
+	return 10 * time.Minute
+}
+
+// expeditionBoat picks the smallest owned boat that can reach a region
+func expeditionBoat(region game.Region) (game.Boat, bool) {
+	for _, boat := range game.GetAllBoats() {
+		if boat.Tier >= region.Tier && player.OwnsBoat(boat.Name) {
+			return boat, true
+		}
+	}
+	return game.Boat{}, false
+}
+
+// expeditionFuel returns the fuel bill for sending a boat out
+func expeditionFuel(boat game.Boat, duration time.Duration) int {
+	return boat.FuelCost * int(duration/expeditionCastInterval())
+}
+
+// The crew bring their own bait, an all-rounder that every species takes
+var crewBait = game.BaitType{Name: "Crew Bait", Strength: 2}
+
+// expeditionCatch picks what the crew lands on one cast, with the same odds
+// as fishing by hand from the boat. Callers must hold mu.
+func expeditionCatch(region game.Region, boat game.Boat) game.Fish {
+	return game.RollCatch(chooseSpecies(crewBait, boat.Tier, boat.Location, region.Fish()))
+}
+
+// resolveExpedition brings a returned boat home and lands its haul, returning
+// the report. Returns nil while the boat is still out. Callers must hold mu.
+func resolveExpedition() *game.ExpeditionReport {
+	expedition := player.Expedition
+	if expedition == nil || !expedition.IsBack(time.Now()) {
+		return nil
+	}
+
+	report := &game.ExpeditionReport{Expedition: *expedition}
+	region, _ := game.GetRegionByName(expedition.Region)
+	boat, _ := game.GetBoatByName(expedition.Boat)
+
+	report.Casts = int(expedition.Duration / expeditionCastInterval())
+	for i := 0; i < report.Casts; i++ {
+		// Same odds as a cast by hand, with the crew using our rod
+		catchChance := (rand.Float64() * 10) + float64(player.RodStrength) + float64(crewBait.Strength)
+		catchChance *= weatherFactor
+		catchChance *= eventCatchFactor()
+		if catchChance < 5 {
+			continue
+		}
+
+		fish := expeditionCatch(region, boat).CaughtAt(boat.Location, time.Now())
+		report.Catches = append(report.Catches, fish)
+		report.Loot = append(report.Loot, keepCatch(fish)...)
+	}
+
+	player.Expedition = nil
+	return report
+}
+
+// checkExpedition lands the haul of a boat that has just come back.
+// Returns whether there's still a boat out.
+func checkExpedition() bool {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if report := resolveExpedition(); report != nil {
+		haulReport = report
+		showBanner(fmt.Sprintf("⛵ Your %s is back from %s with %d catches! See Expeditions for the report.",
+			report.Expedition.Boat, report.Expedition.Region, len(report.Catches)))
+	}
+	return player.Expedition != nil
+}
+
+func (m model) updateExpeditions(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	regions := game.GetAllRegions()
+	durations := expeditionDurations()
+
+	switch msg.String() {
+	case "q", "esc":
+		m.state = "menu"
+		updateCurrentUIState("menu")
+	case "up", "k":
+		if m.regionCursor > 0 {
+			m.regionCursor--
+		}
+	case "down", "j":
+		if m.regionCursor < len(regions)-1 {
+			m.regionCursor++
+		}
+	case "left", "h":
+		if m.durationCursor > 0 {
+			m.durationCursor--
+		}
+	case "right", "l":
+		if m.durationCursor < len(durations)-1 {
+			m.durationCursor++
+		}
+	case "enter", " ":
+		region := regions[m.regionCursor]
+		duration := durations[m.durationCursor]
+
+		mu.Lock()
+		boat, ok := expeditionBoat(region)
+		fuel := expeditionFuel(boat, duration)
+		started := false
+		switch {
+		case player.Expedition != nil:
+			m.message = fmt.Sprintf("Your %s is already out on an expedition.", player.Expedition.Boat)
+		case !ok:
+			m.message = fmt.Sprintf("You need a bigger boat to reach %s.", region.Name)
+		case !player.StartExpedition(region, boat, duration, fuel, time.Now()):
+			m.message = fmt.Sprintf("You need $%d for the fuel.", fuel)
+		default:
+			m.message = fmt.Sprintf("Your %s sets off for %s. It'll be back in %s.", boat.Name, region.Name, formatTimeLeft(duration))
+			started = true
+		}
+		mu.Unlock()
+
+		if started {
+			saveGameProgress()
+			return m, expeditionTick()
+		}
+	}
+	return m, nil
+}
+
+// renderHaulReport draws what an expedition brought back
+func renderHaulReport(report *game.ExpeditionReport) string {
+	content := strings.Builder{}
+
+	expedition := report.Expedition
+	content.WriteString(accentStyle.Render(fmt.Sprintf("%s - %s", expedition.Boat, expedition.Region)) + "\n")
+	content.WriteString(infoStyle.Render(fmt.Sprintf("Back %s after %s, %d casts",
+		expedition.ReturnsAt().Format("Jan 2 15:04"), formatTimeLeft(expedition.Duration), report.Casts)) + "\n\n")
+
+	if len(report.Catches) == 0 {
+		content.WriteString("The crew came back empty-handed.\n")
+		return content.String()
+	}
+
+	// Group the haul by species, biggest counts first
+	counts := map[string]int{}
+	names := []string{}
+	for _, fish := range report.Catches {
+		if counts[fish.Name] == 0 {
+			names = append(names, fish.Name)
+		}
+		counts[fish.Name]++
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		return counts[names[i]] > counts[names[j]]
+	})
+	for _, name := range names {
+		content.WriteString(fmt.Sprintf("  %2dx %s\n", counts[name], name))
+	}
+
+	content.WriteString("\n" + successStyle.Render(fmt.Sprintf("%d catches, %d lbs, worth $%d",
+		len(report.Catches), report.TotalWeight(), report.TotalValue())) + "\n")
+
+	if len(report.Loot) > 0 {
+		items := []string{}
+		for _, drop := range report.Loot {
+			items = append(items, drop.Describe())
+		}
+		content.WriteString(successStyle.Render("Found: "+strings.Join(items, ", ")) + "\n")
+	}
+
+	return content.String()
+}
+
+func (m model) renderExpeditions() string {
+	content := strings.Builder{}
+
+	content.WriteString(historyHeaderStyle.Render("EXPEDITIONS") + "\n\n")
+
+	// A boat already out shows its timer instead of the planner
+	if expedition := player.Expedition; expedition != nil {
+		remaining := time.Until(expedition.ReturnsAt())
+		content.WriteString(fmt.Sprintf("Your %s is out fishing %s.\n", expedition.Boat, expedition.Region))
+		content.WriteString(infoStyle.Render(fmt.Sprintf("Back in %d:%02d:%02d", int(remaining.Hours()),
+			int(remaining.Minutes())%60, int(remaining.Seconds())%60)) + "\n")
+	} else {
+		durations := expeditionDurations()
+		content.WriteString("Send a boat out to fish on its own. The haul comes home with it.\n\n")
+
+		for i, region := range game.GetAllRegions() {
+			line := region.Name
+			if boat, ok := expeditionBoat(region); ok {
+				line += fmt.Sprintf(" - %s, $%d fuel", boat.Name, expeditionFuel(boat, durations[m.durationCursor]))
+			} else {
+				boat, _ := game.BoatForTier(region.Tier)
+				line += " - needs a " + boat.Name
+			}
+
+			if i == m.regionCursor {
+				content.WriteString(highlightedMenuItemStyle.Render("> "+line) + "\n")
+				if m.width >= 50 {
+					content.WriteString("    " + region.Description + "\n")
+				}
+			} else {
+				content.WriteString("  " + line + "\n")
+			}
+		}
+
+		lengths := []string{}
+		for i, duration := range durations {
+			if i == m.durationCursor {
+				lengths = append(lengths, highlightedMenuItemStyle.Render(formatTimeLeft(duration)))
+			} else {
+				lengths = append(lengths, formatTimeLeft(duration))
+			}
+		}
+		content.WriteString("\nLength: " + strings.Join(lengths, "  ") + "\n")
+	}
+
+	// The last haul stays on the board until the next one comes in
+	if haulReport != nil {
+		content.WriteString("\n" + historyHeaderStyle.Render("LAST HAUL") + "\n\n")
+		content.WriteString(renderHaulReport(haulReport))
+	}
+
+	return boxStyle.Render(content.String())
+}
+
+func (m model) renderExpeditionReport() string {
+	content := strings.Builder{}
+
+	content.WriteString(historyHeaderStyle.Render("⛵ YOUR BOAT IS BACK!") + "\n\n")
+	if haulReport != nil {
+		content.WriteString(renderHaulReport(haulReport))
+	}
+
+	return boxStyle.Render(content.String())
+}
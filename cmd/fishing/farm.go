@@ -0,0 +1,279 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/fishing-game/game"
+)
+
+// tendFarm brings the ponds up to date and shows a banner when fish have
+// grown up in them. Callers must hold mu.
+func tendFarm() {
+	grown := player.TendFarm(time.Now())
+	if grown == 1 {
+		showBanner("🐣 A young fish grew up in your ponds")
+	} else if grown > 1 {
+		showBanner(fmt.Sprintf("🐣 %d young fish grew up in your ponds", grown))
+	}
+}
+
+// openFarm switches to the farm screen with the ponds up to date
+func (m model) openFarm() (tea.Model, tea.Cmd) {
+	mu.Lock()
+	tendFarm()
+	mu.Unlock()
+
+	m.state = "farm"
+	m.farmCursor = 0
+	m.farmPicking = false
+	m.message = ""
+	updateCurrentUIState("farm")
+	return m, nil
+}
+
+// farmCandidates returns the inventory positions of fish that can go in a pond
+func farmCandidates(pond game.Pond) []int {
+	candidates := []int{}
+	for i, fish := range player.FishCaught {
+		if player.CanStockPond(pond, fish) {
+			candidates = append(candidates, i)
+		}
+	}
+	return candidates
+}
+
+// farmHeldBack returns how many fish in the inventory are of a kind the pond
+// takes, but can't go in because they're rare variants or were kept against
+// the rules
+func farmHeldBack(pond game.Pond) int {
+	count := 0
+	for _, fish := range player.FishCaught {
+		plain := fish
+		plain.Catch.Variant = ""
+		if pond.CanStock(plain) && !player.CanStockPond(pond, fish) {
+			count++
+		}
+	}
+	return count
+}
+
+func (m model) updateFarm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	mu.Lock()
+	m, changed := m.handleFarmKey(msg)
+	mu.Unlock()
+
+	// Save right away so purchases, stock and harvests aren't lost
+	// (saveGameProgress takes the lock itself)
+	if changed {
+		saveGameProgress()
+	}
+	return m, nil
+}
+
+// handleFarmKey handles a key on the farm screen. Returns whether the farm,
+// the inventory or the money changed. Callers must hold mu.
+func (m model) handleFarmKey(msg tea.KeyMsg) (model, bool) {
+	changed := false
+
+	// Choosing a fish from the inventory to stock the pond with
+	if m.farmPicking {
+		pond := player.Farm[m.farmPond]
+		candidates := farmCandidates(pond)
+		switch msg.String() {
+		case "q", "esc":
+			m.farmPicking = false
+			m.farmCursor = m.farmPond
+		case "up", "k":
+			if m.farmCursor > 0 {
+				m.farmCursor--
+			}
+		case "down", "j":
+			if m.farmCursor < len(candidates)-1 {
+				m.farmCursor++
+			}
+		case "enter", " ":
+			if m.farmCursor >= len(candidates) {
+				return m, false
+			}
+			fish := player.FishCaught[candidates[m.farmCursor]]
+			if player.StockPond(m.farmPond, candidates[m.farmCursor], time.Now()) {
+				changed = true
+				m.message = fmt.Sprintf("%s is now swimming in your %s.", fish.Name, pond.Kind().Name)
+			}
+			m.farmPicking = false
+			m.farmCursor = m.farmPond
+		}
+		return m, changed
+	}
+
+	switch msg.String() {
+	case "q", "esc":
+		m.state = "menu"
+		updateCurrentUIState("menu")
+	case "up", "k":
+		if m.farmCursor > 0 {
+			m.farmCursor--
+		}
+	case "down", "j":
+		if m.farmCursor < len(player.Farm)-1 {
+			m.farmCursor++
+		}
+	case "s":
+		if m.farmCursor >= len(player.Farm) {
+			m.message = "You don't have a pond yet. Press b to build one."
+			return m, false
+		}
+		pond := player.Farm[m.farmCursor]
+		if pond.IsFull() {
+			m.message = fmt.Sprintf("Your %s is full! Harvest it to make room.", pond.Kind().Name)
+		} else if len(farmCandidates(pond)) == 0 && farmHeldBack(pond) > 0 {
+			m.message = "Rare variants and fish kept against the rules can't go in a pond."
+		} else if len(farmCandidates(pond)) == 0 && pond.Species != "" {
+			m.message = fmt.Sprintf("You have no %s to stock the pond with.", pond.Species)
+		} else if len(farmCandidates(pond)) == 0 {
+			m.message = "You have no fish to stock the pond with. Go catch some!"
+		} else {
+			m.farmPicking = true
+			m.farmPond = m.farmCursor
+			m.farmCursor = 0
+			m.message = ""
+		}
+	case "h":
+		if m.farmCursor >= len(player.Farm) {
+			return m, false
+		}
+		count, money := player.HarvestPond(m.farmCursor, eventPriceFactor(), time.Now())
+		if count == 0 {
+			m.message = "Nothing to harvest yet. A breeding pair always stays behind."
+		} else {
+			changed = true
+			m.message = fmt.Sprintf("Harvested %d %s for $%d!", count, player.Farm[m.farmCursor].Species, money)
+		}
+	case "b":
+		next, ok := player.NextPond()
+		if !ok {
+			m.message = "Your farm has every pond there is!"
+		} else if player.BuildPond(time.Now()) {
+			changed = true
+			m.message = fmt.Sprintf("Built a %s for $%d! It holds %d fish.", next.Name, next.Cost, next.Capacity)
+			m.farmCursor = len(player.Farm) - 1
+		} else {
+			m.message = fmt.Sprintf("A %s costs $%d. You need more money!", next.Name, next.Cost)
+		}
+	}
+	return m, changed
+}
+
+// renderPondStock draws a pond's fish: adults as fish, young ones by how
+// far they've grown
+func renderPondStock(pond game.Pond) string {
+	stock := strings.Builder{}
+	for i := 0; i < pond.Adults; i++ {
+		stock.WriteString(accentStyle.Render("><>"))
+	}
+	for _, progress := range pond.Young {
+		switch {
+		case progress < 0.33:
+			stock.WriteString(infoStyle.Render("°"))
+		case progress < 0.66:
+			stock.WriteString(infoStyle.Render("∝"))
+		default:
+			stock.WriteString(infoStyle.Render("<>"))
+		}
+	}
+	for i := pond.Count(); i < pond.Kind().Capacity; i++ {
+		stock.WriteString(infoStyle.Render("~"))
+	}
+	return stock.String()
+}
+
+func (m model) renderFarm() string {
+	content := strings.Builder{}
+
+	content.WriteString(historyHeaderStyle.Render(fmt.Sprintf("FISH FARM (%d/%d ponds)", len(player.Farm), len(game.GetAllPonds()))) + "\n\n")
+
+	// Choosing a fish from the inventory
+	if m.farmPicking {
+		pond := player.Farm[m.farmPond]
+		content.WriteString(accentStyle.Render(fmt.Sprintf("Choose a fish for your %s:", pond.Kind().Name)) + "\n\n")
+
+		// Show a page of the inventory around the cursor
+		candidates := farmCandidates(pond)
+		start := m.farmCursor - m.itemsPerPage/2
+		if start > len(candidates)-m.itemsPerPage {
+			start = len(candidates) - m.itemsPerPage
+		}
+		if start < 0 {
+			start = 0
+		}
+		end := start + m.itemsPerPage
+		if end > len(candidates) {
+			end = len(candidates)
+		}
+
+		for i := start; i < end; i++ {
+			fish := player.FishCaught[candidates[i]]
+			line := fmt.Sprintf("%s (%d lbs, $%d)", fish.Name, fish.Weight, fish.Value)
+			if i == m.farmCursor {
+				content.WriteString(highlightedMenuItemStyle.Render("> "+line) + " " + generateFishPattern(fish) + "\n")
+			} else {
+				content.WriteString("  " + line + " " + generateFishPattern(fish) + "\n")
+			}
+		}
+		content.WriteString("\n" + infoStyle.Render(fmt.Sprintf("%d of %d fish", m.farmCursor+1, len(candidates))))
+		return boxStyle.Render(content.String())
+	}
+
+	if len(player.Farm) == 0 {
+		content.WriteString(infoStyle.Render("You don't have any ponds yet. Build one, stock it with a pair of fish") + "\n")
+		content.WriteString(infoStyle.Render("and they'll breed and grow even while you're away.") + "\n")
+	}
+
+	priceFactor := eventPriceFactor()
+	for i, pond := range player.Farm {
+		kind := pond.Kind()
+		species := pond.Species
+		if species == "" {
+			species = "Empty"
+		}
+
+		header := fmt.Sprintf("%s - %s (%d/%d)", kind.Name, species, pond.Count(), kind.Capacity)
+		if i == m.farmCursor {
+			content.WriteString(highlightedMenuItemStyle.Render("> "+header) + "\n")
+		} else {
+			content.WriteString(menuItemStyle.Render("  "+header) + "\n")
+		}
+		content.WriteString("  " + renderPondStock(pond) + "\n")
+
+		// How the pond is getting on
+		var status string
+		switch {
+		case pond.Species == "":
+			status = "Stock it with fish from your inventory"
+		case pond.IsFull():
+			status = "Full! Harvest it to make room for young"
+		case pond.Adults < 2 && len(pond.Young) == 0:
+			status = "Needs a second fish to breed"
+		default:
+			status = fmt.Sprintf("%d adults, %d young", pond.Adults, len(pond.Young))
+			if eta, ok := pond.GrowthETA(); ok {
+				status += fmt.Sprintf(" | next grown in %s", formatTimeLeft(eta))
+			}
+		}
+		content.WriteString("  " + infoStyle.Render(status) + "\n")
+
+		if count := pond.Harvestable(); count > 0 {
+			content.WriteString("  " + successStyle.Render(fmt.Sprintf("Ready to harvest: %d for $%d", count, pond.HarvestValue(priceFactor))) + "\n")
+		}
+		content.WriteString("\n")
+	}
+
+	if next, ok := player.NextPond(); ok {
+		content.WriteString(infoStyle.Render(fmt.Sprintf("Build: %s holds %d fish for $%d", next.Name, next.Capacity, next.Cost)))
+	}
+
+	return boxStyle.Render(content.String())
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/fishing-game/game"
+)
+
+// festivalSpecies returns the fish only found during the festivals that are
+// on, that can be reached at the given habitat tier
+func festivalSpecies(tier int) []game.Fish {
+	species := []game.Fish{}
+	for _, festival := range game.CurrentFestivals(time.Now()) {
+		species = append(species, festival.Species...)
+	}
+	return withinReach(species, tier)
+}
+
+// festivalLegendaryBonus returns the extra chance of hooking a legendary
+// creature during the festivals that are on
+func festivalLegendaryBonus() float64 {
+	bonus := 0.0
+	for _, festival := range game.CurrentFestivals(time.Now()) {
+		bonus += festival.LegendaryBonus
+	}
+	return bonus
+}
+
+// isFestivalLegendary reports whether a festival that's on draws in a legendary creature
+func isFestivalLegendary(name string) bool {
+	for _, festival := range game.CurrentFestivals(time.Now()) {
+		if festival.Legendary == name {
+			return true
+		}
+	}
+	return false
+}
+
+// announceFestivalFish adds a catch to the festival collections and shows a
+// banner for each one it was new to. Callers must hold mu.
+func announceFestivalFish(fish game.Fish) {
+	for _, festival := range player.CollectFestivalFish(fish, time.Now()) {
+		showBanner(fmt.Sprintf("%s New for your %s collection: %s (%d/%d)", festival.Icon, festival.Name,
+			fish.Name, len(player.Festivals[festival.Name]), len(festival.Collection())))
+	}
+}
+
+// renderFestivalBanner draws a banner in each festival's own colors while it's on
+func renderFestivalBanner(width int) string {
+	now := time.Now()
+	banners := []string{}
+	for _, festival := range game.CurrentFestivals(now) {
+		_, end := festival.Next(now)
+		daysLeft := int(end.Sub(now).Hours()/24) + 1
+
+		text := fmt.Sprintf("%s %s! %s (%d days left)", festival.Icon, festival.Name, festival.Description, daysLeft)
+		if daysLeft == 1 {
+			text = fmt.Sprintf("%s %s! %s (last day)", festival.Icon, festival.Name, festival.Description)
+		}
+		if width < 60 {
+			text = fmt.Sprintf("%s %s festival", festival.Icon, festival.Name)
+		}
+
+		bannerWidth := 60
+		if width < 70 {
+			bannerWidth = width - 4
+		}
+		banners = append(banners, lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#FFFFFF")).
+			Background(lipgloss.Color(festival.Color)).
+			Padding(0, 1).
+			Width(bannerWidth).
+			Render(text))
+	}
+	return strings.Join(banners, "\n")
+}
+
+// openFestivals shows the festival calendar and collections
+func (m model) openFestivals() (tea.Model, tea.Cmd) {
+	m.state = "festivals"
+	m.message = ""
+	updateCurrentUIState("festivals")
+	return m, nil
+}
+
+func (m model) updateFestivals(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc":
+		m.state = "menu"
+		updateCurrentUIState("menu")
+	}
+	return m, nil
+}
+
+func (m model) renderFestivals() string {
+	content := strings.Builder{}
+	now := time.Now()
+
+	content.WriteString(historyHeaderStyle.Render("FESTIVALS") + "\n\n")
+
+	for _, festival := range game.GetAllFestivals() {
+		start, end := festival.Next(now)
+
+		// When it's on, by the calendar it's kept by
+		header := fmt.Sprintf("%s %s", festival.Icon, festival.Name)
+		var when string
+		if festival.IsOn(now) {
+			when = successStyle.Render(fmt.Sprintf("On now until %s", end.AddDate(0, 0, -1).Format("Mon, Jan 2")))
+		} else {
+			days := int(start.Sub(now).Hours()/24) + 1
+			when = infoStyle.Render(fmt.Sprintf("%s, in %d days", start.Format("Mon, Jan 2 2006"), days))
+		}
+		content.WriteString(accentStyle.Render(header) + " " + infoStyle.Render("("+festival.Calendar+" calendar)") + " - " + when + "\n")
+
+		if m.width >= 60 {
+			content.WriteString(infoStyle.Render(festival.Description) + "\n")
+		}
+
+		// What's been collected so far
+		collection := festival.Collection()
+		items := []string{}
+		for _, name := range collection {
+			if player.HasFestivalFish(festival.Name, name) {
+				items = append(items, successStyle.Render("✓ "+name))
+			} else {
+				items = append(items, infoStyle.Render("· ???"))
+			}
+		}
+		content.WriteString(fmt.Sprintf("Collection %d/%d: %s\n\n", len(player.Festivals[festival.Name]), len(collection), strings.Join(items, "  ")))
+	}
+
+	content.WriteString(infoStyle.Render("Festival fish can only be caught while their festival is on"))
+
+	return boxStyle.Render(content.String())
+}
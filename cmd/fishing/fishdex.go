@@ -0,0 +1,216 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/fishing-game/game"
+)
+
+// Number of Fishdex entries listed per page
+const fishdexPageSize = 12
+
+func (m model) updateFishdex(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc":
+		m.state = "menu"
+		updateCurrentUIState("menu")
+	case "up", "k":
+		if m.fishdexCursor > 0 {
+			m.fishdexCursor--
+		}
+	case "down", "j":
+		if m.fishdexCursor < len(availableFish)-1 {
+			m.fishdexCursor++
+		}
+	case "left", "p":
+		m.fishdexCursor -= fishdexPageSize
+		if m.fishdexCursor < 0 {
+			m.fishdexCursor = 0
+		}
+	case "right", "n":
+		m.fishdexCursor += fishdexPageSize
+		if m.fishdexCursor > len(availableFish)-1 {
+			m.fishdexCursor = len(availableFish) - 1
+		}
+	case "enter", " ":
+		m.state = "fishdexDetail"
+		updateCurrentUIState("fishdexDetail")
+	}
+	return m, nil
+}
+
+func (m model) updateFishdexDetail(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc", "enter":
+		m.state = "fishdex"
+		updateCurrentUIState("fishdex")
+	case "up", "k", "left":
+		if m.fishdexCursor > 0 {
+			m.fishdexCursor--
+		}
+	case "down", "j", "right":
+		if m.fishdexCursor < len(availableFish)-1 {
+			m.fishdexCursor++
+		}
+	}
+	return m, nil
+}
+
+// completionLine formats how much of one catalog category has been discovered
+func completionLine(label string, fishList []game.Fish) string {
+	found := player.CountDiscovered(fishList)
+	return fmt.Sprintf("%s %d/%d (%d%%)", label, found, len(fishList), found*100/len(fishList))
+}
+
+// variantLine formats how many rare variants have been collected
+func variantLine() string {
+	species := []game.Fish{}
+	for _, fish := range availableFish {
+		if !fish.IsTrash {
+			species = append(species, fish)
+		}
+	}
+	total := len(species) * len(game.GetAllVariants())
+	found := player.CountVariants(species)
+	return fmt.Sprintf("Variants %d/%d (%d%%)", found, total, found*100/total)
+}
+
+// variantIcons shows which variants of a species have been collected
+func variantIcons(fish game.Fish) string {
+	icons := []string{}
+	for _, variant := range game.GetAllVariants() {
+		if player.HasVariant(fish.Name, variant.Name) {
+			icons = append(icons, variantStyle(variant).Render(variant.Icon))
+		} else {
+			icons = append(icons, infoStyle.Render("·"))
+		}
+	}
+	return strings.Join(icons, "")
+}
+
+func (m model) renderFishdex() string {
+	content := strings.Builder{}
+
+	content.WriteString(historyHeaderStyle.Render("FISHDEX") + "\n\n")
+
+	// Completion for each category
+	content.WriteString(completionLine("Overall", availableFish) + " | " + variantLine() + "\n")
+	if m.width >= 60 {
+		content.WriteString(infoStyle.Render(completionLine("Regular", game.GetRegularFish())+" | "+
+			completionLine("Legendary", game.GetLegendaryFish())+" | "+
+			completionLine("Trash", game.GetTrashItems())) + "\n\n")
+	} else {
+		content.WriteString(infoStyle.Render(completionLine("Reg", game.GetRegularFish())) + "\n")
+		content.WriteString(infoStyle.Render(completionLine("Leg", game.GetLegendaryFish())) + "\n")
+		content.WriteString(infoStyle.Render(completionLine("Trash", game.GetTrashItems())) + "\n\n")
+	}
+
+	// Entries on the current page
+	page := m.fishdexCursor / fishdexPageSize
+	start := page * fishdexPageSize
+	end := start + fishdexPageSize
+	if end > len(availableFish) {
+		end = len(availableFish)
+	}
+
+	for i := start; i < end; i++ {
+		fish := availableFish[i]
+
+		// Undiscovered entries only show their silhouette
+		var line string
+		if player.HasCaught(fish.Name) {
+			line = fmt.Sprintf("#%02d %-22s x%d", i+1, fish.Name, player.Stats.Species[fish.Name].TimesCaught)
+		} else {
+			line = fmt.Sprintf("#%02d %-22s", i+1, "???")
+		}
+
+		if i == m.fishdexCursor {
+			content.WriteString(highlightedMenuItemStyle.Render(line))
+		} else {
+			content.WriteString(menuItemStyle.Render(line))
+		}
+
+		if m.width >= 50 && !fish.IsTrash {
+			content.WriteString(" " + variantIcons(fish))
+		}
+		if m.width >= 50 {
+			if player.HasCaught(fish.Name) {
+				content.WriteString(" " + generateFishPattern(fish))
+			} else {
+				content.WriteString(" " + generateFishSilhouette(fish))
+			}
+		}
+		content.WriteString("\n")
+	}
+
+	totalPages := (len(availableFish) + fishdexPageSize - 1) / fishdexPageSize
+	content.WriteString("\n" + infoStyle.Render(fmt.Sprintf("Page %d/%d", page+1, totalPages)))
+
+	return boxStyle.Render(content.String())
+}
+
+func (m model) renderFishdexDetail() string {
+	content := strings.Builder{}
+
+	fish := availableFish[m.fishdexCursor]
+	record, discovered := player.Stats.Species[fish.Name]
+
+	if !discovered {
+		content.WriteString(historyHeaderStyle.Render(fmt.Sprintf("#%02d ???", m.fishdexCursor+1)) + "\n\n")
+		content.WriteString(generateFishSilhouette(fish) + "\n\n")
+		content.WriteString("Not yet discovered.\n")
+		content.WriteString(infoStyle.Render("Rumored habitat: "+fish.Habitat) + "\n")
+		if boat, ok := game.RequiredBoat(fish); ok {
+			content.WriteString(infoStyle.Render("You'll need a "+boat.Name+" to reach it") + "\n")
+		}
+		return boxStyle.Render(content.String())
+	}
+
+	content.WriteString(historyHeaderStyle.Render(fmt.Sprintf("#%02d %s", m.fishdexCursor+1, fish.Name)) + "\n\n")
+	content.WriteString(generateFishPattern(fish) + "\n\n")
+
+	// Category
+	if fish.IsLegendary {
+		content.WriteString(accentStyle.Render("Legendary creature") + "\n")
+	} else if fish.IsTrash {
+		content.WriteString(infoStyle.Render("Trash") + "\n")
+	} else {
+		content.WriteString(infoStyle.Render(fmt.Sprintf("Rarity %d/10", fish.Rarity)) + "\n")
+	}
+
+	// Catalog details
+	preferredTime := fish.PreferredTime
+	if preferredTime == "" {
+		preferredTime = "Any time"
+	}
+	habitat := fish.Habitat
+	if boat, ok := game.RequiredBoat(fish); ok {
+		habitat += " (needs a " + boat.Name + ")"
+	}
+	content.WriteString(fmt.Sprintf("Habitat:        %s\n", habitat))
+	content.WriteString(fmt.Sprintf("Most active:    %s\n", preferredTime))
+	content.WriteString(fmt.Sprintf("Color/Pattern:  %s / %s\n", fish.Color, fish.Pattern))
+	content.WriteString(fmt.Sprintf("Typical size:   %d lbs, $%d\n\n", fish.Weight, fish.Value))
+
+	// The player's own record
+	content.WriteString(successStyle.Render("YOUR RECORD") + "\n")
+	content.WriteString(fmt.Sprintf("First caught:   %s\n", record.FirstCaught.Format("Jan 2 2006 15:04")))
+	content.WriteString(fmt.Sprintf("Times caught:   %d\n", record.TimesCaught))
+	content.WriteString(fmt.Sprintf("Heaviest catch: %d lbs\n", record.Heaviest))
+
+	// Rare variants, which trash doesn't come in
+	if !fish.IsTrash {
+		content.WriteString("\n" + successStyle.Render("VARIANTS") + "\n")
+		for _, variant := range game.GetAllVariants() {
+			if player.HasVariant(fish.Name, variant.Name) {
+				content.WriteString(variantStyle(variant).Render(variant.Icon+" "+variant.Name) + "\n")
+			} else {
+				content.WriteString(infoStyle.Render("· ???") + "\n")
+			}
+		}
+	}
+
+	return boxStyle.Render(content.String())
+}
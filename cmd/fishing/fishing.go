@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"strings"
 	"time"
@@ -32,63 +33,323 @@ func autoTick() tea.Cmd {
 
 // Custom message type for catch result
 type catchResultMsg struct {
-	success bool
-	fish    game.Fish
+	success   bool
+	snapped   bool            // The fish was hooked but the line snapped
+	fish      game.Fish       // The caught fish, or the one that got away
+	leveledUp bool            // The catch took the player to a new level
+	loot      []game.LootDrop // What was inside, if the catch was a container
+	comboLost int             // Length of the combo the cast broke, if it broke one
 }
 
 // The main fishing animation function is now in model.go as part of the Update method
 // and the catch completion logic is in the completeFishing method
 
-func (m model) updateAutoFishingAnimation() (tea.Model, tea.Cmd) {
-	// Progress through auto-fishing animation
-	m.autoFishTick++
-
-	// Every 10 ticks, try to catch a fish
-	if m.autoFishTick >= 10 {
-		// Calculate catch chance with weather factor and time of day factor
-		catchChance := (rand.Float64() * 10) + float64(player.RodStrength) + float64(player.BaitStrength)
-		catchChance *= weatherFactor
-		catchChance *= timeFactor // Apply time of day factor
-
-		success := catchChance >= 5
-
-		var fish game.Fish
-		if success {
-			// Choose a fish based on rarity and time of day
-			fish = chooseFish()
-			// Add to inventory
-			player.AddFish(fish)
-
-			// Reset tick counter and return catch result
-			m.autoFishTick = 0
-			return m, func() tea.Msg {
-				return catchResultMsg{
-					success: success,
-					fish:    fish,
-				}
-			}
+// landFish reels in a hooked fish, wearing down the rod and checking whether
+// the line holds. Landed fish are kept (see keepCatch), and the loot from any
+// container that was landed is returned.
+// Callers must hold mu.
+func landFish(fish game.Fish) (bool, []game.LootDrop) {
+	snapped := rand.Float64() < player.LineSnapChance(fish.Weight)
+	player.WearRod(fish.Weight)
+	if snapped {
+		return false, nil
+	}
+	return true, keepCatch(fish)
+}
+
+// keepCatch puts a landed fish into the inventory and today's catch log,
+// checks it against the regulations and takes it out of the stocks where it
+// was caught. Every fish landed, however it was caught, goes through here.
+// Returns the loot from any container that was landed. Callers must hold mu.
+func keepCatch(fish game.Fish) []game.LootDrop {
+	// A warden might come by to check what's already been kept
+	if regulationsOn && rand.Float64() < wardenChance {
+		wardenInspection()
+	}
+
+	loot := recordCatch(fish)
+	checkRegulations(fish)
+	player.Deplete(fish.Catch.Location, fish, fish.Catch.Time)
+	return loot
+}
+
+// recordCatch adds a fish to the inventory and to today's catch log.
+// Containers like treasure chests are opened on the spot instead, and their
+// loot is returned. Callers must hold mu.
+func recordCatch(fish game.Fish) []game.LootDrop {
+	loot := player.OpenContainer(fish.Name)
+	if loot == nil {
+		player.AddFish(fish)
+	}
+	player.AddXP(game.CatchXP(fish))
+
+	today := time.Now().Format("2006-01-02")
+	dailyCatches[today] = append(dailyCatches[today], fish)
+	if !contains(dateList, today) {
+		dateList = append(dateList, today)
+	}
+
+	player.RecordCatch(fish, time.Now())
+	announceAchievements(player.CheckAchievements(time.Now()))
+
+	player.RefreshQuests(time.Now())
+	announceQuests(player.QuestCatch(fish))
+
+	announceFestivalFish(fish)
+	announceVariant(fish)
+
+	return loot
+}
+
+// announceLoot shows a banner for what was inside a container landed while
+// the player wasn't watching. Callers must hold mu.
+func announceLoot(container string, loot []game.LootDrop) {
+	if len(loot) == 0 {
+		return
+	}
+
+	items := []string{}
+	for _, drop := range loot {
+		items = append(items, drop.Describe())
+	}
+	showBanner(fmt.Sprintf("📦 Opened a %s: %s", container, strings.Join(items, ", ")))
+}
+
+// announceVariant adds a rare variant to the collection and shows a banner
+// the first time one turns up. Callers must hold mu.
+func announceVariant(fish game.Fish) {
+	if variant, ok := player.CollectVariant(fish); ok {
+		showBanner(fmt.Sprintf("%s New variant for the Fishdex: %s %s!", variant.Icon, variant.Name, fish.Name))
+	}
+}
+
+// announceQuests shows a banner for completed quests and their rewards.
+// Callers must hold mu.
+func announceQuests(completed []game.Quest) {
+	for _, quest := range completed {
+		showBanner(fmt.Sprintf("📜 Quest complete: %s (+$%d, +%d XP)",
+			quest.Description, quest.RewardMoney, quest.RewardXP))
+	}
+}
+
+// announceAchievements shows a banner for newly unlocked achievements.
+// Callers must hold mu.
+func announceAchievements(unlocked []game.Achievement) {
+	if len(unlocked) == 0 {
+		return
+	}
+
+	names := []string{}
+	for _, achievement := range unlocked {
+		names = append(names, achievement.Name)
+	}
+	showBanner("🏆 Achievement unlocked: " + strings.Join(names, ", "))
+}
+
+// showBanner displays a notification under the stats bar for a few seconds.
+// Callers must hold mu.
+func showBanner(text string) {
+	// Don't hide a notification that is still showing
+	if time.Now().Before(bannerUntil) {
+		text = bannerText + " | " + text
+	}
+
+	bannerText = text
+	bannerUntil = time.Now().Add(8 * time.Second)
+}
+
+// cycleBait equips the next kind of bait the player has in stock
+func cycleBait() string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	allBait := game.GetAllBait()
+	current := 0
+	for i, bait := range allBait {
+		if bait.Name == player.Bait {
+			current = i
+			break
+		}
+	}
+
+	for offset := 1; offset <= len(allBait); offset++ {
+		next := allBait[(current+offset)%len(allBait)]
+		if player.EquipBait(next.Name) {
+			return fmt.Sprintf("Switched to %s (%d left).", next.Name, player.BaitLeft())
+		}
+	}
+	return "You're out of bait! Buy some at the shop."
+}
+
+// cycleBoat takes out the next owned boat, ending back on the shore
+func cycleBoat() string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(player.Boats) == 0 {
+		return "You don't own a boat. Buy one at the shop to reach deeper waters."
+	}
+
+	// The shore comes first, then the boats in the order they were bought
+	options := append([]string{""}, player.Boats...)
+	current := 0
+	for i, name := range options {
+		if name == player.Boat {
+			current = i
+			break
+		}
+	}
+
+	// Skip any boat that's away on an expedition
+	next := ""
+	for offset := 1; offset <= len(options); offset++ {
+		next = options[(current+offset)%len(options)]
+		if player.UseBoat(next) {
+			break
+		}
+	}
+	if next == "" {
+		return "Back to fishing from the shore."
+	}
+	boat, _ := game.GetBoatByName(next)
+	if boat.FuelCost > 0 {
+		return fmt.Sprintf("Heading out %s in your %s ($%d fuel per cast).", boat.Location, boat.Name, boat.FuelCost)
+	}
+	return fmt.Sprintf("Heading out %s in your %s.", boat.Location, boat.Name)
+}
+
+// Custom message type to refresh the chum countdown
+type chumTickMsg time.Time
+
+func chumTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return chumTickMsg(t)
+	})
+}
+
+// chumAt returns the chum working at a location, if any. Chum only draws
+// fish where it was thrown, and wears off by the clock, so this holds for
+// background fishing too.
+func chumAt(location string) (game.Chum, bool) {
+	if activeChum == "" || chumLocation != location || !time.Now().Before(chumExpires) {
+		return game.Chum{}, false
+	}
+	return game.GetChumByName(activeChum)
+}
+
+// currentChum returns the chum working where the player is fishing, if any
+func currentChum() (game.Chum, bool) {
+	return chumAt(player.Location())
+}
+
+// chumBiteBoost returns the bite rate multiplier from any active chum
+func chumBiteBoost() float64 {
+	if chum, ok := currentChum(); ok {
+		return chum.BiteBoost
+	}
+	return 1.0
+}
+
+// cycleChum picks the next kind of chum the player has in stock to throw
+func cycleChum() string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	allChum := game.GetAllChum()
+	current := 0
+	for i, chum := range allChum {
+		if chum.Name == player.Chum {
+			current = i
+			break
+		}
+	}
+
+	for offset := 1; offset <= len(allChum); offset++ {
+		next := allChum[(current+offset)%len(allChum)]
+		if player.ChumStock[next.Name] > 0 {
+			player.Chum = next.Name
+			return fmt.Sprintf("You'll throw %s next (%d left).", next.Name, player.ChumStock[next.Name])
 		}
+	}
+	return "You don't have any chum. Buy some at the shop."
+}
+
+// throwChum throws the picked chum into the water, or the first chum in
+// stock if none is picked or the picked one has run out
+func throwChum() (string, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if chum, ok := currentChum(); ok {
+		remaining := time.Until(chumExpires).Round(time.Second)
+		return fmt.Sprintf("The %s is still working (%s left).", chum.Name, remaining), false
+	}
 
-		// If not successful, reset counter but keep auto-fishing
-		m.autoFishTick = 0
-		m.autoFishMsg = "No bites yet, still fishing..."
-	} else {
-		// Update auto-fishing message with animation dots
-		dots := strings.Repeat(".", (m.autoFishTick%3)+1)
-		m.autoFishMsg = fmt.Sprintf("Auto-fishing in progress%s", dots)
+	if player.ChumStock[player.Chum] == 0 {
+		for _, chum := range game.GetAllChum() {
+			if player.ChumStock[chum.Name] > 0 {
+				player.Chum = chum.Name
+				break
+			}
+		}
 	}
 
-	// Continue auto-fishing
-	return m, autoTick()
+	chum, ok := game.GetChumByName(player.Chum)
+	if !ok || !player.UseChum(chum.Name) {
+		return "You don't have any chum. Buy some at the shop.", false
+	}
+	activeChum = chum.Name
+	chumExpires = time.Now().Add(chum.Duration)
+	chumLocation = player.Location()
+	return fmt.Sprintf("You throw the %s. Fish are gathering!", chum.Name), true
 }
 
-func chooseFish() game.Fish {
+// chooseFish picks what bites where the player is fishing, given the bait on
+// the hook (the zero BaitType for a bare hook) and the habitat tier the cast
+// reaches (see LaunchBoat), and rolls the size of this particular fish
+func chooseFish(bait game.BaitType, tier int) game.Fish {
 	mu.Lock()
 	defer mu.Unlock()
 
-	// Decide whether to catch trash (10-15% chance)
+	return game.RollCatch(chooseSpecies(bait, tier, player.Location(), availableFish))
+}
+
+// withinReach keeps the fish whose habitat can be reached at the given tier
+func withinReach(fishList []game.Fish, tier int) []game.Fish {
+	reachable := []game.Fish{}
+	for _, fish := range fishList {
+		if game.RequiredTier(fish) <= tier {
+			reachable = append(reachable, fish)
+		}
+	}
+	return reachable
+}
+
+// containsFish reports whether a species is in a list of fish
+func containsFish(fishList []game.Fish, name string) bool {
+	for _, fish := range fishList {
+		if fish.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// chooseSpecies picks which catalog entry bites on a cast at a location, out
+// of a pool of the species that live there. Callers must hold mu.
+func chooseSpecies(bait game.BaitType, tier int, location string, pool []game.Fish) game.Fish {
+	// Legendary creatures that got away recently are keeping their distance
+	pool = withoutEscaped(pool)
+
+	// Decide whether to catch trash (10-15% chance, more with a bare hook)
+	trashThreshold := 0.12
+	if bait.Name == "" {
+		trashThreshold = 0.2
+	}
+	trashThreshold -= player.TrashReduction()
+	trashThreshold += eventTrashBonus()
 	trashChance := rand.Float64()
-	if trashChance < 0.12 {
+	if trashChance < trashThreshold {
 		trashItems := game.GetTrashItems()
 		if len(trashItems) > 0 {
 			return trashItems[rand.Intn(len(trashItems))]
@@ -109,8 +370,33 @@ func chooseFish() game.Fish {
 		legendaryThreshold += 0.01
 	}
 
+	// Legend Lore skill and dishes like the Sushi Platter
+	legendaryThreshold += player.LegendaryBonus()
+	legendaryThreshold += buffStrength("legendary")
+	legendaryThreshold += eventLegendaryBonus()
+	legendaryThreshold += festivalLegendaryBonus()
+
+	// Some baits lure legendary creatures when used at the right time
+	if bait.Legendary && (bait.PreferredTime == "" || bait.PreferredTime == timeOfDay) {
+		legendaryThreshold += 0.01
+	}
+
 	if legendaryChance < legendaryThreshold {
-		legendaryFish := game.GetLegendaryFish()
+		// Deep-water creatures need a boat to reach
+		legendaryFish := []game.Fish{}
+		for _, fish := range withinReach(pool, tier) {
+			if fish.IsLegendary {
+				legendaryFish = append(legendaryFish, fish)
+			}
+		}
+
+		// A festival's creature comes to whoever is fishing while it's on
+		for _, fish := range legendaryFish {
+			if isFestivalLegendary(fish.Name) {
+				return fish
+			}
+		}
+
 		// Filter for ones that prefer current time
 		timeSpecificLegendary := []game.Fish{}
 		for _, fish := range legendaryFish {
@@ -119,6 +405,17 @@ func chooseFish() game.Fish {
 			}
 		}
 
+		// Narrow down further to the creatures drawn to our bait
+		baitedLegendary := []game.Fish{}
+		for _, fish := range timeSpecificLegendary {
+			if bait.Attracts(fish) {
+				baitedLegendary = append(baitedLegendary, fish)
+			}
+		}
+		if len(baitedLegendary) > 0 {
+			timeSpecificLegendary = baitedLegendary
+		}
+
 		if len(timeSpecificLegendary) > 0 {
 			return timeSpecificLegendary[rand.Intn(len(timeSpecificLegendary))]
 		} else if len(legendaryFish) > 0 {
@@ -126,14 +423,41 @@ func chooseFish() game.Fish {
 		}
 	}
 
+	// Chum in the water draws certain species in
+	chum, chumActive := chumAt(location)
+
 	// Get fish that prefer current time of day or have no specific time preference
-	timeFish := game.GetFishByTimeOfDay(timeOfDay)
+	timeFish := []game.Fish{}
+	for _, fish := range withinReach(pool, tier) {
+		if fish.PreferredTime == timeOfDay || fish.PreferredTime == "" {
+			timeFish = append(timeFish, fish)
+		}
+	}
 	if len(timeFish) == 0 {
 		// Fallback to all fish if no time-appropriate fish
-		timeFish = availableFish
+		timeFish = withinReach(pool, tier)
+	}
+	if len(timeFish) == 0 {
+		// Nothing lives here that the cast can reach
+		trashItems := game.GetTrashItems()
+		return trashItems[rand.Intn(len(trashItems))]
+	}
+
+	// Festival fish turn up while their festival is on
+	timeFish = append(timeFish, festivalSpecies(tier)...)
+
+	// World events draw in species that wouldn't be about at this time of day
+	eventWeights := eventSpecies()
+	for _, fish := range withinReach(pool, tier) {
+		if eventWeights[fish.Name] > 0 && !fish.IsLegendary && !containsFish(timeFish, fish.Name) {
+			timeFish = append(timeFish, fish)
+		}
 	}
 
-	// Calculate total rarity, adjusted by weather and time factors
+	// Stocks left where we're fishing
+	now := time.Now()
+
+	// Calculate total rarity, adjusted by weather, time factors and populations
 	totalRarity := 0
 	adjustedRarities := make([]int, len(timeFish))
 
@@ -182,10 +506,28 @@ func chooseFish() game.Fish {
 			}
 		}
 
+		// Fish that go for our bait bite far more often
+		if bait.Attracts(fish) {
+			adjustedRarity += 4
+		}
+
+		// Chum shifts the mix toward the species it draws
+		if chumActive && chum.Draws(fish) {
+			adjustedRarity += chum.WeightBonus
+		}
+
+		// So do world events, or they drive it off
+		adjustedRarity += eventWeights[fish.Name]
+
 		if adjustedRarity < 1 {
 			adjustedRarity = 1
 		}
 
+		// Overfished species bite less often. Weights are scaled up by 10 so
+		// a partly depleted stock still makes a difference.
+		level := player.PopulationLevel(location, fish, now)
+		adjustedRarity = int(math.Ceil(float64(adjustedRarity) * 10 * level))
+
 		adjustedRarities[i] = adjustedRarity
 		totalRarity += adjustedRarity
 	}
@@ -64,6 +64,44 @@ var fishermanWithFish = `
 
 // Generate a fish pattern that scales with terminal width
 func generateFishPattern(fish game.Fish) string {
+	// Apply color to the pattern
+	coloredPattern := fishColorStyle(fish).Render(fishShape(fish))
+	return coloredPattern
+}
+
+// fishColorStyle returns the style a fish is drawn in. Rare variants stand
+// out in colors of their own.
+func fishColorStyle(fish game.Fish) lipgloss.Style {
+	if variant, ok := game.VariantOf(fish); ok {
+		return variantStyle(variant)
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(fishColorCode(fish.Color)))
+}
+
+// variantStyle returns the style a rare variant is drawn in
+func variantStyle(variant game.Variant) lipgloss.Style {
+	style := lipgloss.NewStyle().Bold(true)
+	switch variant.Name {
+	case "Albino":
+		return style.Foreground(lipgloss.Color("#FFF0F5"))
+	case "Melanistic":
+		return style.Foreground(lipgloss.Color("#7B6F9C"))
+	case "Golden":
+		return style.Foreground(lipgloss.Color("#FFD700")).Background(lipgloss.Color("#5C4400"))
+	default:
+		// Giants
+		return style.Foreground(lipgloss.Color("#FF6F3C")).Underline(true)
+	}
+}
+
+// generateFishSilhouette draws the shape of a fish not yet discovered, without
+// giving away its colors
+func generateFishSilhouette(fish game.Fish) string {
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("#444444")).Render(fishShape(fish))
+}
+
+// fishShape returns the uncolored ASCII shape for a fish, based on its size and pattern
+func fishShape(fish game.Fish) string {
 	// Start with base pattern
 	var pattern string
 
@@ -151,9 +189,14 @@ func generateFishPattern(fish game.Fish) string {
 		}
 	}
 
+	return pattern
+}
+
+// fishColorCode maps a fish's color name to a terminal color
+func fishColorCode(color string) string {
 	// Set color for the fish based on its color property
 	var colorCode string
-	switch fish.Color {
+	switch color {
 	case "Red":
 		colorCode = "#FF5555"
 	case "Blue":
@@ -184,9 +227,7 @@ func generateFishPattern(fish game.Fish) string {
 		colorCode = "#FFFFFF" // Default white
 	}
 
-	// Apply color to the pattern
-	coloredPattern := lipgloss.NewStyle().Foreground(lipgloss.Color(colorCode)).Render(pattern)
-	return coloredPattern
+	return colorCode
 }
 
 // Variable to track if we're in a small terminal
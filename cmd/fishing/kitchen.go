@@ -0,0 +1,174 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/fishing-game/game"
+)
+
+// activeBuff is a dish that has been eaten and is still working
+type activeBuff struct {
+	Dish    game.Dish
+	Expires time.Time
+}
+
+// SavedBuff is an active buff as stored in the save file. The remaining time
+// is kept rather than the expiry, so buffs don't run out while the game is closed.
+type SavedBuff struct {
+	Dish      string
+	Remaining time.Duration
+}
+
+// Custom message type to refresh the buff countdowns
+type buffTickMsg time.Time
+
+func buffTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return buffTickMsg(t)
+	})
+}
+
+// currentBuffs returns the buffs that haven't worn off yet. Like chum, buffs
+// wear off by the clock, so this holds for background fishing too.
+func currentBuffs() []activeBuff {
+	now := time.Now()
+	current := []activeBuff{}
+	for _, buff := range activeBuffs {
+		if now.Before(buff.Expires) {
+			current = append(current, buff)
+		}
+	}
+	return current
+}
+
+// buffStrength returns the combined strength of the active buffs of one kind
+func buffStrength(kind string) float64 {
+	strength := 0.0
+	for _, buff := range currentBuffs() {
+		if buff.Dish.Buff == kind {
+			strength += buff.Dish.Strength
+		}
+	}
+	return strength
+}
+
+// eatDish eats a dish from the pantry. A new dish replaces any buff of the same kind.
+func eatDish(dish game.Dish) (string, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if !player.EatDish(dish.Name) {
+		return fmt.Sprintf("You haven't cooked any %s.", dish.Name), false
+	}
+
+	buffs := []activeBuff{}
+	for _, buff := range currentBuffs() {
+		if buff.Dish.Buff != dish.Buff {
+			buffs = append(buffs, buff)
+		}
+	}
+	activeBuffs = append(buffs, activeBuff{Dish: dish, Expires: time.Now().Add(dish.Duration)})
+
+	return fmt.Sprintf("You eat the %s: %s for %d minutes!", dish.Name, dish.BuffDescription(), int(dish.Duration.Minutes())), true
+}
+
+// savedBuffs converts the active buffs for the save file
+func savedBuffs() []SavedBuff {
+	saved := []SavedBuff{}
+	for _, buff := range currentBuffs() {
+		saved = append(saved, SavedBuff{Dish: buff.Dish.Name, Remaining: time.Until(buff.Expires)})
+	}
+	return saved
+}
+
+// restoreBuffs restarts the buffs from a save file with the time they had left
+func restoreBuffs(saved []SavedBuff) {
+	activeBuffs = nil
+	for _, buff := range saved {
+		if dish, ok := game.GetDishByName(buff.Dish); ok && buff.Remaining > 0 {
+			activeBuffs = append(activeBuffs, activeBuff{Dish: dish, Expires: time.Now().Add(buff.Remaining)})
+		}
+	}
+}
+
+func (m model) updateKitchen(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	dishes := game.GetAllDishes()
+
+	switch msg.String() {
+	case "q", "esc":
+		m.state = "menu"
+		updateCurrentUIState("menu")
+	case "up", "k":
+		if m.kitchenCursor > 0 {
+			m.kitchenCursor--
+		}
+	case "down", "j":
+		if m.kitchenCursor < len(dishes)-1 {
+			m.kitchenCursor++
+		}
+	case "enter", " ":
+		dish := dishes[m.kitchenCursor]
+		mu.Lock()
+		cooked := player.Cook(dish)
+		mu.Unlock()
+
+		if !cooked {
+			m.message = fmt.Sprintf("You don't have the ingredients for %s.", dish.Name)
+			return m, nil
+		}
+		m.message = fmt.Sprintf("You cooked %s! Press e to eat it.", dish.Name)
+		saveGameProgress()
+	case "e":
+		message, eaten := eatDish(dishes[m.kitchenCursor])
+		m.message = message
+		if eaten {
+			saveGameProgress()
+			return m, buffTick()
+		}
+	}
+	return m, nil
+}
+
+func (m model) renderKitchen() string {
+	content := strings.Builder{}
+
+	content.WriteString(historyHeaderStyle.Render("KITCHEN") + "\n\n")
+
+	for i, dish := range game.GetAllDishes() {
+		canCook := player.HasIngredients(dish.Ingredients)
+
+		title := fmt.Sprintf("%s (%s, %d min)", dish.Name, dish.BuffDescription(), int(dish.Duration.Minutes()))
+		if cooked := player.Pantry[dish.Name]; cooked > 0 {
+			title += fmt.Sprintf(" - %d ready", cooked)
+		}
+
+		if i == m.kitchenCursor {
+			content.WriteString(highlightedMenuItemStyle.Render("> "+title) + "\n")
+		} else if canCook {
+			content.WriteString(successStyle.Render("  "+title) + "\n")
+		} else {
+			content.WriteString("  " + title + "\n")
+		}
+
+		// What goes into it, and how much of it we have
+		parts := []string{}
+		for _, ingredient := range dish.Ingredients {
+			parts = append(parts, fmt.Sprintf("%d/%d %s", player.CountFish(ingredient.Name), ingredient.Count, ingredient.Name))
+		}
+		needs := "    " + strings.Join(parts, ", ")
+		if canCook {
+			content.WriteString(successStyle.Render(needs) + "\n")
+		} else {
+			content.WriteString(infoStyle.Render(needs) + "\n")
+		}
+
+		if i == m.kitchenCursor && m.width >= 50 {
+			content.WriteString("    " + dish.Description + "\n")
+		}
+	}
+
+	return boxStyle.Render(content.String())
+}
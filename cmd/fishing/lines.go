@@ -0,0 +1,279 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/fishing-game/game"
+)
+
+// lineStatus is what an auto-fishing line is up to. It isn't saved: every
+// line is cast afresh when the game starts.
+type lineStatus struct {
+	NextBite  time.Time // When the line next gets a bite
+	LastCatch string    // What happened on the last bite, "" before the first
+	LastTime  time.Time // When that was
+}
+
+// linesTickMsg refreshes the bite timers on the lines panel
+type linesTickMsg time.Time
+
+func linesTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return linesTickMsg(t)
+	})
+}
+
+// openLines switches to the lines panel and starts its timer
+func (m model) openLines() (tea.Model, tea.Cmd) {
+	m.state = "lines"
+	m.lineCursor = 0
+	m.message = ""
+	updateCurrentUIState("lines")
+
+	// Only one timer at a time, even when popping in and out quickly
+	if m.linesTicking {
+		return m, nil
+	}
+	m.linesTicking = true
+	return m, linesTick()
+}
+
+// syncLines keeps a status for every line the player has out, casting any
+// new ones. Callers must hold mu.
+func syncLines() {
+	for len(lines) < player.LineCount() {
+		lines = append(lines, lineStatus{NextBite: time.Now().Add(getRandomFishingDuration())})
+	}
+	lines = lines[:player.LineCount()]
+}
+
+// tendLines reels in every line that has had a bite and casts it again
+func tendLines() {
+	mu.Lock()
+	syncLines()
+	now := time.Now()
+	due := []int{}
+	for i, line := range lines {
+		if !now.Before(line.NextBite) {
+			due = append(due, i)
+		}
+	}
+	mu.Unlock()
+
+	landedAny := false
+	for _, i := range due {
+		if castLine(i) {
+			landedAny = true
+		}
+	}
+
+	// Auto-save when a fish is caught in background
+	// (saveGameProgress takes the lock itself)
+	if landedAny {
+		saveGameProgress()
+	}
+}
+
+// castLine resolves a bite on one line, lands whatever took the bait and
+// sends the line back out. Returns whether anything was landed.
+func castLine(i int) bool {
+	result := ""
+	landed := false
+
+	// The main rod sits out while the player is fishing with it by hand.
+	// Check the current state without locking since this is just a rough check
+	mainRodInUse := i == 0 && (currentUIState == "fishing" || currentUIState == "fishResult" || currentUIState == "boss")
+
+	mu.Lock()
+	broken := player.IsRodBroken()
+	mu.Unlock()
+
+	if broken {
+		result = "Rod broken"
+	} else if !mainRodInUse {
+		mu.Lock()
+		// Each background cast uses up a piece of the line's own bait
+		bait, _ := player.UseLineBait(i)
+		tier := player.LaunchBoat()
+
+		// Calculate catch chance with weather factor
+		catchChance := (rand.Float64() * 10) + float64(player.RodStrength) + float64(bait.Strength)
+		catchChance *= weatherFactor
+		catchChance *= chumBiteBoost()
+		catchChance *= 1 + buffStrength("catch_chance")
+		catchChance *= eventCatchFactor()
+		mu.Unlock()
+
+		if catchChance >= 5 {
+			// Choose a fish the same way as the foreground line, except
+			// that legendary creatures only go for a line someone is
+			// holding to fight them
+			mu.Lock()
+			chosenFish := game.RollCatch(chooseSpecies(bait, tier, player.Location(), withoutLegendary(availableFish)))
+			chosenFish = chosenFish.CaughtAt(player.Location(), time.Now())
+			var loot []game.LootDrop
+			landed, loot = landFish(chosenFish)
+			announceLoot(chosenFish.Name, loot)
+			mu.Unlock()
+
+			if landed {
+				result = "Landed " + chosenFish.Name
+			} else {
+				result = chosenFish.Name + " snapped the line"
+			}
+		} else {
+			mu.Lock()
+			player.WearRod(0)
+			mu.Unlock()
+			result = "Nothing took the bait"
+		}
+	}
+
+	// Set a new random fishing duration (skills affect it, so read under the lock)
+	mu.Lock()
+	if i < len(lines) {
+		lines[i].NextBite = time.Now().Add(getRandomFishingDuration())
+		if result != "" {
+			lines[i].LastCatch = result
+			lines[i].LastTime = time.Now()
+		}
+	}
+	mu.Unlock()
+
+	return landed
+}
+
+// cycleLineBait puts the next kind of bait in stock on a line
+func cycleLineBait(line int) string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	allBait := game.GetAllBait()
+	current := 0
+	for i, bait := range allBait {
+		if bait.Name == player.LineBait(line) {
+			current = i
+			break
+		}
+	}
+
+	for offset := 1; offset <= len(allBait); offset++ {
+		next := allBait[(current+offset)%len(allBait)]
+		if player.SetLineBait(line, next.Name) {
+			return fmt.Sprintf("Line %d is now fishing with %s (%d left).", line+1, next.Name, player.BaitStock[next.Name])
+		}
+	}
+	return "You're out of bait! Buy some at the shop."
+}
+
+func (m model) updateLines(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc":
+		m.state = "menu"
+		updateCurrentUIState("menu")
+	case "up", "k":
+		if m.lineCursor > 0 {
+			m.lineCursor--
+		}
+	case "down", "j":
+		if m.lineCursor < player.LineCount()-1 {
+			m.lineCursor++
+		}
+	case "b":
+		m.message = cycleLineBait(m.lineCursor)
+	case "u":
+		mu.Lock()
+		cost, ok := player.NextRodHolderCost()
+		bought := false
+		if !ok {
+			m.message = fmt.Sprintf("You already have %d lines out. That's as many as you can watch!", game.MaxLines)
+		} else if !player.BuyRodHolder() {
+			m.message = fmt.Sprintf("A rod holder costs $%d. You need more money!", cost)
+		} else {
+			m.message = fmt.Sprintf("Set up a rod holder for $%d! You have %d lines out.", cost, player.LineCount())
+			bought = true
+		}
+		mu.Unlock()
+
+		if bought {
+			saveGameProgress()
+		}
+	case "a":
+		autoFishing = !autoFishing
+		if autoFishing {
+			m.message = "Auto-fishing enabled. Your lines are in the water."
+		} else {
+			m.message = "Auto-fishing disabled. Your lines are reeled in."
+		}
+	}
+	return m, nil
+}
+
+func (m model) renderLines() string {
+	content := strings.Builder{}
+
+	content.WriteString(historyHeaderStyle.Render(fmt.Sprintf("LINES (%d/%d)", player.LineCount(), game.MaxLines)) + "\n\n")
+
+	if autoFishing {
+		content.WriteString(successStyle.Render("Auto-fishing is on. Every line fishes on its own.") + "\n\n")
+	} else {
+		content.WriteString(infoStyle.Render("Auto-fishing is off. Press a to put the lines in the water.") + "\n\n")
+	}
+
+	now := time.Now()
+	for i := 0; i < player.LineCount(); i++ {
+		// A line bought a moment ago gets its status on the next check
+		var line lineStatus
+		if i < len(lines) {
+			line = lines[i]
+		}
+
+		name := "Main rod"
+		if i > 0 {
+			name = fmt.Sprintf("Holder %d", i)
+		}
+
+		bait := player.LineBait(i)
+		baitInfo := fmt.Sprintf("%s [%d]", bait, player.BaitStock[bait])
+		if player.BaitStock[bait] == 0 {
+			baitInfo = "bare hook"
+		}
+
+		var status string
+		switch {
+		case player.IsRodBroken():
+			status = errorStyle.Render("rod broken")
+		case !autoFishing:
+			status = "reeled in"
+		default:
+			wait := line.NextBite.Sub(now)
+			if wait < 0 || line.NextBite.IsZero() {
+				wait = 0
+			}
+			status = fmt.Sprintf("bite in %d:%02d", int(wait.Minutes()), int(wait.Seconds())%60)
+		}
+
+		row := fmt.Sprintf("%d. %-9s %-16s %s", i+1, name, baitInfo, status)
+		if i == m.lineCursor {
+			content.WriteString(highlightedMenuItemStyle.Render("> "+row) + "\n")
+		} else {
+			content.WriteString("  " + row + "\n")
+		}
+
+		// The last bite on each line, if there's room for it
+		if line.LastCatch != "" && m.width >= 50 {
+			ago := now.Sub(line.LastTime).Round(time.Second)
+			content.WriteString(infoStyle.Render(fmt.Sprintf("     %s, %s ago", line.LastCatch, ago)) + "\n")
+		}
+	}
+
+	if cost, ok := player.NextRodHolderCost(); ok {
+		content.WriteString("\n" + infoStyle.Render(fmt.Sprintf("Another rod holder costs $%d", cost)))
+	}
+
+	return boxStyle.Render(content.String())
+}
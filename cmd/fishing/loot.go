@@ -0,0 +1,106 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/fishing-game/game"
+)
+
+// lootTickMsg steps the container reveal animation on the result screen
+type lootTickMsg time.Time
+
+func lootTick() tea.Cmd {
+	return tea.Tick(time.Millisecond*300, func(t time.Time) tea.Msg {
+		return lootTickMsg(t)
+	})
+}
+
+// Chest frames, from closed to thrown open
+var chestFrames = []string{
+	`
+  ________
+ |________|
+ |   []   |
+ |________|
+`,
+	`
+   ______
+  /      \
+ |~~~~~~~~|
+ |   []   |
+ |________|
+`,
+	`
+ *  ______  *
+   /      \
+ *|$ $ $ $ |*
+  |   []   |
+  |________|
+`,
+}
+
+// Bottle frames, from corked to the note rolled out
+var bottleFrames = []string{
+	`
+    _
+   |=|
+  /   \
+ |  ~  |
+ |_____|
+`,
+	`
+    =
+   | |
+  /   \
+ |  ~  |
+ |_____|
+`,
+	`
+     ______
+ =  |~~~~~ |
+   |  ~~~~ |
+   |_______|
+`,
+}
+
+// containerFrames returns the reveal animation frames for a container
+func containerFrames(container string) []string {
+	if container == "Message in a Bottle" {
+		return bottleFrames
+	}
+	return chestFrames
+}
+
+// revealDone reports whether the reveal animation has shown everything
+func revealDone(container string, loot []game.LootDrop, frame int) bool {
+	return frame >= len(containerFrames(container))+len(loot)
+}
+
+// renderLootReveal draws the container opening, then its contents one by one
+func renderLootReveal(container string, loot []game.LootDrop, frame int) string {
+	content := strings.Builder{}
+
+	frames := containerFrames(container)
+	shown := frame
+	if shown >= len(frames) {
+		shown = len(frames) - 1
+	}
+	content.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#FFAA00")).Render(frames[shown]) + "\n")
+
+	// Rewards appear once the lid is open
+	for i, drop := range loot {
+		if frame < len(frames)+i {
+			break
+		}
+		if drop.Kind == "treasure_map" {
+			content.WriteString(accentStyle.Render("🗺  "+drop.Describe()+" You dig up the buried treasure:") + "\n")
+			continue
+		}
+		content.WriteString(successStyle.Render("  ✦ "+drop.Describe()) + "\n")
+	}
+
+	return content.String()
+}
@@ -8,6 +8,7 @@ import (
 	"math/rand"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
@@ -29,11 +30,50 @@ var (
 	saveDir        string     // Directory for save files
 	todaySaveFile  string     // Path to today's save file
 
+	// Set when loading a save written before the inventory was stored in it
+	migrateInventory bool
+
 	// History tracking
-	dailyCatches     map[string][]game.Fish // Map of date strings to fish catches
-	dateList         []string               // List of dates with catches, sorted
-	viewingDate      string                 // Currently viewed date in history
-	isViewingHistory bool                   // Whether user is viewing history
+	dailyCatches     map[string][]game.Fish        // Map of date strings to fish catches
+	dailyReleases    map[string][]game.Release     // Map of date strings to released fish
+	dailyEvents      map[string][]game.ActiveEvent // Map of date strings to world events going on
+	dateList         []string                      // List of dates with catches, sorted
+	viewingDate      string                        // Currently viewed date in history
+	isViewingHistory bool                          // Whether user is viewing history
+
+	// Chum thrown at the current spot
+	activeChum   string    // Name of the chum in the water, "" for none
+	chumExpires  time.Time // When the chum stops working
+	chumLocation string    // Where the chum was thrown
+
+	// Dishes eaten in the kitchen that are still working
+	activeBuffs []activeBuff
+
+	// Tournament in progress, nil when not competing
+	tournament *game.Tournament
+	trophies   []game.Trophy // Trophies won in past tournaments
+
+	// Haul of the last expedition to come back, nil if none this session
+	haulReport *game.ExpeditionReport
+
+	// What each auto-fishing line is up to, the main rod first
+	lines []lineStatus
+
+	// Whether the local fishing regulations are enforced
+	regulationsOn bool
+
+	// Random world events that have started, see currentEvents for all running ones
+	worldEvents []game.ActiveEvent
+
+	// News feed whose headlines start world events, "" for none
+	newsFeed  string
+	newsRules []game.NewsRule // Keywords that start each event
+	newsSeen  []string        // IDs of the headlines already read
+	newsError string          // Last problem reading the feed, "" if it was fine
+
+	// Notification banner shown under the stats bar
+	bannerText  string
+	bannerUntil time.Time
 
 	// Time of day variables
 	timeOfDay  string        // Current time period (morning, afternoon, evening, night)
@@ -88,9 +128,11 @@ var timePeriods = []TimeOfDay{
 
 // DailySave represents the saveable game state for a single day
 type DailySave struct {
-	FishCaught []game.Fish // Fish caught on this day
-	Date       string      // Date in YYYY-MM-DD format
-	SaveTime   time.Time   // When the game was last saved
+	FishCaught []game.Fish        // Fish caught on this day
+	Released   []game.Release     // Fish let go again on this day
+	Events     []game.ActiveEvent // World events going on during this day
+	Date       string             // Date in YYYY-MM-DD format
+	SaveTime   time.Time          // When the game was last saved
 }
 
 // GameSave represents the main saveable game state (excluding daily catches)
@@ -100,13 +142,37 @@ type GameSave struct {
 	LastActiveTime time.Time
 	AutoFishing    bool
 	SaveTime       time.Time // When the game was saved
+	SaveVersion    int       // Format version of the save file
+	ActiveChum     string    // Chum in the water when the game was saved
+	ChumExpires    time.Time // When that chum stops working
+	ChumLocation   string    // Where that chum was thrown
+	Trophies       []game.Trophy
+	Buffs          []SavedBuff        // Active buffs with the time they have left
+	Regulations    bool               // Whether the fishing regulations are enforced
+	Events         []game.ActiveEvent // Random world events that had started
+	NewsSeen       []string           // Headlines already read from the news feed
 }
 
+// Version 1 keeps the unsold inventory in the main save instead of rebuilding
+// it from today's catches, so selling fish no longer erases the day's history
+const currentSaveVersion = 1
+
 func main() {
 	// Parse command line flags
 	flag.BoolVar(&testMode, "test", false, "Run in test mode with shorter fishing times (5-10 seconds)")
+	newsRulesFile := ""
+	flag.StringVar(&newsFeed, "news", "", "RSS, Atom or JSON news feed (a file or URL) whose headlines start world events")
+	flag.StringVar(&newsRulesFile, "news-rules", "", "JSON file of keyword rules matching headlines to world events")
 	flag.Parse()
 
+	// Bad news rules are reported before the game takes over the screen
+	var err error
+	newsRules, err = loadNewsRules(newsRulesFile)
+	if err != nil {
+		fmt.Printf("Error loading news rules: %v\n", err)
+		os.Exit(1)
+	}
+
 	rand.Seed(time.Now().UnixNano())
 
 	// Set up save directory structure
@@ -158,6 +224,8 @@ func setupSaveDirectory() {
 func initializeGame() {
 	// Initialize maps and slices
 	dailyCatches = make(map[string][]game.Fish)
+	dailyReleases = make(map[string][]game.Release)
+	dailyEvents = make(map[string][]game.ActiveEvent)
 	dateList = []string{}
 	isViewingHistory = false
 
@@ -176,12 +244,27 @@ func initializeGame() {
 	// Load all available dates and their catches
 	loadAllDailyCatches()
 
+	// Saves from before lifetime stats or the Fishdex records were kept
+	// rebuild them from history
+	if player.Stats.Species == nil || !player.Stats.HasRecords() {
+		rebuildStatsFromHistory()
+	}
+
+	// Roll today's and this week's quests if they're not on the board yet
+	player.RefreshQuests(time.Now())
+
 	// These are always initialized fresh
 	availableFish = game.GetAllFish()
 	stopIdle = make(chan bool)
 
 	// Initialize time of day
 	updateTimeOfDay()
+
+	// Land the haul of a boat that came back while the game was closed
+	haulReport = resolveExpedition()
+
+	// Note the world events going on as the game starts
+	updateWorldEvents(0)
 }
 
 // updateTimeOfDay checks the current system time and updates time-related variables
@@ -213,26 +296,29 @@ func updateTimeOfDay() {
 	timeFactor = 1.0
 }
 
-// saveGameProgress saves the main game state (without fish data)
+// saveGameProgress saves the main game state and today's catch log
 func saveGameProgress() {
 	mu.Lock()
 	defer mu.Unlock()
 
-	// Save main game state (without fish catches)
+	// Save main game state, including the unsold inventory
 	gameSave := GameSave{
 		Player:         player,
 		WeatherFactor:  weatherFactor,
 		LastActiveTime: lastActiveTime,
 		AutoFishing:    autoFishing,
 		SaveTime:       time.Now(),
+		SaveVersion:    currentSaveVersion,
+		ActiveChum:     activeChum,
+		ChumExpires:    chumExpires,
+		ChumLocation:   chumLocation,
+		Trophies:       trophies,
+		Buffs:          savedBuffs(),
+		Regulations:    regulationsOn,
+		Events:         worldEvents,
+		NewsSeen:       newsSeen,
 	}
 
-	// Backup player's full fish list
-	allFish := player.FishCaught
-
-	// To avoid duplicating data, clear the fish list as it's saved by day
-	player.FishCaught = []game.Fish{}
-
 	data, err := json.Marshal(gameSave)
 	if err != nil {
 		fmt.Printf("Error marshalling save data: %v\n", err)
@@ -246,9 +332,6 @@ func saveGameProgress() {
 		fmt.Printf("Error writing main save file: %v\n", err)
 	}
 
-	// Restore player's fish list for runtime use
-	player.FishCaught = allFish
-
 	// Save today's catches separately
 	saveTodayCatches()
 }
@@ -257,9 +340,14 @@ func saveGameProgress() {
 func saveTodayCatches() {
 	today := time.Now().Format("2006-01-02")
 
+	// Follow the date so a session running past midnight starts a new file
+	todaySaveFile = filepath.Join(saveDir, today+".json")
+
 	// Create daily save object
 	dailySave := DailySave{
-		FishCaught: player.FishCaught,
+		FishCaught: dailyCatches[today],
+		Released:   dailyReleases[today],
+		Events:     dailyEvents[today],
 		Date:       today,
 		SaveTime:   time.Now(),
 	}
@@ -276,9 +364,6 @@ func saveTodayCatches() {
 		fmt.Printf("Error writing daily save file: %v\n", err)
 	}
 
-	// Update in-memory cache
-	dailyCatches[today] = player.FishCaught
-
 	// Update date list if needed
 	if !contains(dateList, today) {
 		dateList = append(dateList, today)
@@ -302,12 +387,32 @@ func loadGameProgress() bool {
 		return false
 	}
 
-	// Restore game state (without fish catches)
+	// Restore game state
 	player = gameSave.Player
-	player.FishCaught = []game.Fish{} // Clear any fish data that might be in the main save
+	if player.BaitStock == nil {
+		// Saves from before bait was consumable get a starter pack
+		player.BaitStock = map[string]int{player.Bait: 10}
+	}
+	if gameSave.SaveVersion < 1 {
+		// Older saves rebuilt the inventory from today's catches
+		player.FishCaught = []game.Fish{}
+		migrateInventory = true
+	}
 	weatherFactor = gameSave.WeatherFactor
 	lastActiveTime = gameSave.LastActiveTime
 	autoFishing = gameSave.AutoFishing
+	activeChum = gameSave.ActiveChum
+	chumExpires = gameSave.ChumExpires
+	chumLocation = gameSave.ChumLocation
+	if chumLocation == "" {
+		// Saves from before boats only had the shore to throw chum at
+		chumLocation = game.ShoreLocation
+	}
+	trophies = gameSave.Trophies
+	restoreBuffs(gameSave.Buffs)
+	regulationsOn = gameSave.Regulations
+	worldEvents = gameSave.Events
+	newsSeen = gameSave.NewsSeen
 
 	// Print load message with timestamp
 	saveTimeStr := gameSave.SaveTime.Format("Jan 2 15:04:05")
@@ -331,23 +436,27 @@ func loadTodayCatches() {
 		return
 	}
 
-	// Set the fish caught for today
-	player.FishCaught = dailySave.FishCaught
-
-	// Calculate total weight and value
-	player.TotalWeight = 0
-	player.TotalValue = 0
-	for _, fish := range player.FishCaught {
-		player.TotalWeight += fish.Weight
-		player.TotalValue += fish.Value
-	}
-
 	// Update in-memory cache
 	today := time.Now().Format("2006-01-02")
-	dailyCatches[today] = player.FishCaught
+	dailyCatches[today] = dailySave.FishCaught
+	dailyReleases[today] = dailySave.Released
+	dailyEvents[today] = dailySave.Events
+
+	// Older saves kept no inventory of their own, so start from today's catches
+	if migrateInventory {
+		player.FishCaught = append([]game.Fish{}, dailySave.FishCaught...)
+
+		// Calculate total weight and value
+		player.TotalWeight = 0
+		player.TotalValue = 0
+		for _, fish := range player.FishCaught {
+			player.TotalWeight += fish.Weight
+			player.TotalValue += fish.Value
+		}
+	}
 
 	// Print load message
-	fmt.Printf("Loaded %d fish caught today\n", len(player.FishCaught))
+	fmt.Printf("Loaded %d fish caught today\n", len(dailySave.FishCaught))
 }
 
 // loadAllDailyCatches scans the save directory and loads all daily catches
@@ -396,6 +505,28 @@ func loadAllDailyCatches() {
 
 		// Store in the map
 		dailyCatches[date] = dailySave.FishCaught
+		dailyReleases[date] = dailySave.Released
+		dailyEvents[date] = dailySave.Events
+	}
+}
+
+// rebuildStatsFromHistory replays every saved day's catches into the player's
+// lifetime statistics
+func rebuildStatsFromHistory() {
+	dates := append([]string{}, dateList...)
+	sort.Strings(dates)
+
+	// Start the catch counts over so nothing gets counted twice
+	player.Stats = game.CatchStats{TotalSold: player.Stats.TotalSold}
+
+	for _, date := range dates {
+		day, err := time.ParseInLocation("2006-01-02", date, time.Local)
+		if err != nil {
+			continue
+		}
+		for _, fish := range dailyCatches[date] {
+			player.RecordCatch(fish, day)
+		}
 	}
 }
 
@@ -417,13 +548,7 @@ func setViewingDate(date string) {
 
 // getFishCaughtOnDate returns the fish caught on a specific date
 func getFishCaughtOnDate(date string) []game.Fish {
-	// If viewing today, use the player's current catches
-	today := time.Now().Format("2006-01-02")
-	if date == today {
-		return player.FishCaught
-	}
-
-	// Otherwise, get fish from the daily catches map
+	// Get fish from the daily catches map
 	if catches, ok := dailyCatches[date]; ok {
 		return catches
 	}
@@ -432,6 +557,11 @@ func getFishCaughtOnDate(date string) []game.Fish {
 	return []game.Fish{}
 }
 
+// getFishReleasedOnDate returns the fish let go on a specific date
+func getFishReleasedOnDate(date string) []game.Release {
+	return dailyReleases[date]
+}
+
 // getAvailableDates returns the dates that have fish catches
 func getAvailableDates() []string {
 	return dateList
@@ -469,15 +599,18 @@ func startAutoSaveRoutine() {
 
 // Generate a random fishing duration
 func getRandomFishingDuration() time.Duration {
+	var seconds int
 	if testMode {
 		// 5-10 seconds in test mode
-		seconds := rand.Intn(6) + 5 // 5-10 range
-		return time.Second * time.Duration(seconds)
+		seconds = rand.Intn(6) + 5 // 5-10 range
 	} else {
 		// 10 seconds to 2 minutes in normal mode
-		seconds := rand.Intn(111) + 10 // 10-120 seconds (2 min max)
-		return time.Second * time.Duration(seconds)
+		seconds = rand.Intn(111) + 10 // 10-120 seconds (2 min max)
 	}
+
+	// Chum in the water, the Quick Bite skill and some dishes make fish bite sooner
+	duration := time.Second * time.Duration(seconds)
+	return time.Duration(float64(duration) * player.BiteTimeFactor() / chumBiteBoost() / (1 + buffStrength("bite_speed")))
 }
 
 // Start all background routines with panic recovery
@@ -540,6 +673,28 @@ func startBackgroundRoutines() {
 		}
 	}()
 
+	// Start world events routine
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				fmt.Println("Recovered from panic in events routine:", r)
+			}
+		}()
+		startEventRoutine()
+	}()
+
+	// Start news routine if there's a feed to follow
+	if newsFeed != "" {
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					fmt.Println("Recovered from panic in news routine:", r)
+				}
+			}()
+			startNewsRoutine()
+		}()
+	}
+
 	// Start auto-fishing routine
 	go func() {
 		defer func() {
@@ -19,6 +19,10 @@ type model struct {
 	message            string
 	catchSuccess       bool
 	caughtFish         game.Fish
+	lineSnapped        bool            // The last hooked fish broke the line
+	leveledUp          bool            // The last catch took the player to a new level
+	loot               []game.LootDrop // Contents of the last container landed
+	lootFrame          int             // Step of the container reveal animation
 	fishShape          string
 	width              int
 	height             int
@@ -34,6 +38,32 @@ type model struct {
 	historyDates       []string // Available dates for history
 	historyDateIndex   int      // Selected date index
 	historyViewingDate string   // Date currently being viewed
+	shopCursor         int      // Selected item in the shop
+	skillCursor        int      // Selected skill on the profile screen
+	fishdexCursor      int      // Selected catalog entry in the Fishdex
+
+	finishedTournament *game.Tournament // Tournament shown on the results screen
+	aquariumCursor     int              // Selected fish in the tank or the inventory picker
+	aquariumPicking    bool             // Choosing a fish from the inventory to put in the tank
+	aquariumFrame      int              // Animation frame of the fish in the tank
+	aquariumTicking    bool             // Whether the tank animation is running
+	farmCursor         int              // Selected pond, or fish in the inventory picker
+	farmPicking        bool             // Choosing a fish from the inventory to stock a pond with
+	farmPond           int              // Pond being stocked while picking
+	craftCursor        int              // Selected recipe on the crafting screen
+	kitchenCursor      int              // Selected dish in the kitchen
+	regionCursor       int              // Selected region on the expeditions screen
+	durationCursor     int              // Selected expedition length
+	lineCursor         int              // Selected line on the lines panel
+	linesTicking       bool             // Whether the lines panel timer is running
+	releaseCursor      int              // Selected fish when choosing one to release
+	regulationCursor   int              // Location shown on the regulations screen
+	groundCursor       int              // Location shown on the populations screen
+	populationPage     int              // Page of species on the populations screen
+	encounter          *game.Encounter  // Fight with a hooked legendary creature
+	bossFrame          int              // Animation frame of the encounter
+	comboFrame         int              // Animation frame of the combo counter
+	comboLost          int              // Length of the combo the last cast broke
 }
 
 // Custom message type for auto-continuing
@@ -46,12 +76,18 @@ func autoContinue() tea.Cmd {
 }
 
 func initialModel() model {
+	// Open with the haul report if a boat came back while we were away
+	state := "menu"
+	if haulReport != nil {
+		state = "expeditionReport"
+	}
+
 	// Initialize UI state
-	updateCurrentUIState("menu")
+	updateCurrentUIState(state)
 
 	return model{
-		state:              "menu",
-		menuItems:          []string{"Go Fishing", "View Inventory", "View History", "Quit Game"},
+		state:              state,
+		menuItems:          []string{"Go Fishing", "View Inventory", "View History", "Visit Shop", "Profile", "Achievements", "Fishdex", "Quest Board", "Aquarium", "Crafting", "Kitchen", "Tournament", "Expeditions", "Fishing Lines", "Fishing Rules", "Fish Stocks", "Festivals", "Fish Farm", "Quit Game"},
 		selectedItem:       0,
 		fishingState:       0,
 		width:              80,
@@ -71,7 +107,18 @@ func initialModel() model {
 }
 
 func (m model) Init() tea.Cmd {
-	return nil
+	// Keep the countdowns running for chum and buffs left over from last time
+	cmds := []tea.Cmd{}
+	if _, ok := currentChum(); ok {
+		cmds = append(cmds, chumTick())
+	}
+	if len(currentBuffs()) > 0 {
+		cmds = append(cmds, buffTick())
+	}
+	if player.Expedition != nil {
+		cmds = append(cmds, expeditionTick())
+	}
+	return tea.Batch(cmds...)
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -87,6 +134,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			updateCurrentUIState("fishing")
 			if msg.String() == "q" || msg.String() == "esc" {
 				m.state = "menu"
+				m.message = withdrawFromTournament()
 				updateCurrentUIState("menu")
 				return m, nil
 			} else if msg.String() == "a" { // Toggle auto-fishing while fishing
@@ -99,6 +147,19 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			} else if msg.String() == "s" { // Save progress
 				saveGameProgress()
 				m.message = "Game progress saved."
+			} else if msg.String() == "b" { // Switch bait
+				m.message = cycleBait()
+			} else if msg.String() == "x" { // Pick which chum to throw
+				m.message = cycleChum()
+			} else if msg.String() == "v" { // Switch boat
+				m.message = cycleBoat()
+			} else if msg.String() == "c" { // Throw chum
+				message, thrown := throwChum()
+				m.message = message
+				if thrown {
+					saveGameProgress()
+					return m, chumTick()
+				}
 			}
 			return m, nil
 		case "autoFishing":
@@ -155,6 +216,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.inventoryPage--
 				}
 				return m, nil
+			} else if msg.String() == "x" { // Choose a fish to release
+				m.state = "release"
+				m.releaseCursor = 0
+				m.message = ""
+				updateCurrentUIState("release")
+				return m, nil
 			} else if msg.String() == "h" { // Switch to history view
 				m.state = "history"
 				m.historyDates = getAvailableDates()
@@ -240,11 +307,102 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, nil
 			}
+		case "shop":
+			// Track UI state for background processes
+			updateCurrentUIState("shop")
+			return m.updateShop(msg)
+		case "profile":
+			// Track UI state for background processes
+			updateCurrentUIState("profile")
+			return m.updateProfile(msg)
+		case "achievements":
+			// Track UI state for background processes
+			updateCurrentUIState("achievements")
+			return m.updateAchievements(msg)
+		case "fishdex":
+			// Track UI state for background processes
+			updateCurrentUIState("fishdex")
+			return m.updateFishdex(msg)
+		case "fishdexDetail":
+			// Track UI state for background processes
+			updateCurrentUIState("fishdexDetail")
+			return m.updateFishdexDetail(msg)
+		case "quests":
+			// Track UI state for background processes
+			updateCurrentUIState("quests")
+			return m.updateQuests(msg)
+		case "aquarium":
+			// Track UI state for background processes
+			updateCurrentUIState("aquarium")
+			return m.updateAquarium(msg)
+		case "crafting":
+			// Track UI state for background processes
+			updateCurrentUIState("crafting")
+			return m.updateCrafting(msg)
+		case "kitchen":
+			// Track UI state for background processes
+			updateCurrentUIState("kitchen")
+			return m.updateKitchen(msg)
+		case "expeditions":
+			// Track UI state for background processes
+			updateCurrentUIState("expeditions")
+			return m.updateExpeditions(msg)
+		case "lines":
+			// Track UI state for background processes
+			updateCurrentUIState("lines")
+			return m.updateLines(msg)
+		case "release":
+			// Track UI state for background processes
+			updateCurrentUIState("release")
+			return m.updateRelease(msg)
+		case "regulations":
+			// Track UI state for background processes
+			updateCurrentUIState("regulations")
+			return m.updateRegulations(msg)
+		case "populations":
+			// Track UI state for background processes
+			updateCurrentUIState("populations")
+			return m.updatePopulations(msg)
+		case "boss":
+			// Track UI state for background processes
+			updateCurrentUIState("boss")
+			return m.updateBoss(msg)
+		case "festivals":
+			// Track UI state for background processes
+			updateCurrentUIState("festivals")
+			return m.updateFestivals(msg)
+		case "farm":
+			// Track UI state for background processes
+			updateCurrentUIState("farm")
+			return m.updateFarm(msg)
+		case "expeditionReport":
+			// Any key goes on to the menu
+			m.state = "menu"
+			updateCurrentUIState("menu")
+			return m, nil
+		case "tournament":
+			// Track UI state for background processes
+			updateCurrentUIState("tournament")
+			return m.updateTournament(msg)
+		case "tournamentResult":
+			// Track UI state for background processes
+			updateCurrentUIState("tournamentResult")
+			return m.updateTournamentResult(msg)
 		case "fishResult":
 			// Track UI state for background processes
 			updateCurrentUIState("fishResult")
+			// Let the catch go before moving on
+			if msg.String() == "x" && m.canRelease() {
+				if message, ok := releaseCatch(m.caughtFish); ok {
+					m.message = message
+				}
+			}
 			// Any key press immediately continues to next step
-			if autoFishing {
+			if tournament != nil && tournament.IsOver(time.Now()) {
+				return m.finishTournament()
+			}
+			if autoFishing || tournament != nil {
+				// Tournaments keep casting until the time runs out.
 				// Instead of going directly to auto-fishing state, go to fishing state
 				// to show the fishing animation for next catch
 				m.state = "fishing"
@@ -278,6 +436,14 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.fishingProgress = float64(elapsed) / float64(m.fishingDuration)
 			m.fishingState++ // Increment for animation frames
 
+			// Rivals keep fishing while we wait for a bite
+			if tournament != nil {
+				tournament.Advance(time.Now())
+				if tournament.IsOver(time.Now()) {
+					return m.finishTournament()
+				}
+			}
+
 			// Check if fishing is complete
 			if m.fishingProgress >= 1.0 {
 				return m.completeFishing()
@@ -300,9 +466,66 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			updateCurrentUIState("fishing")
 			return m, tick()
 		}
+	case bossTickMsg:
+		// The creature keeps fighting until the encounter is over
+		if m.state != "boss" || m.encounter == nil || m.encounter.IsOver() {
+			return m, nil
+		}
+		m.bossFrame++
+		m.encounter.Advance(time.Now())
+		if m.encounter.IsOver() {
+			return m.endEncounter()
+		}
+		return m, bossTick()
+	case linesTickMsg:
+		// Keep the bite timers counting down while the panel is open
+		if m.state == "lines" {
+			return m, linesTick()
+		}
+		m.linesTicking = false
+	case aquariumTickMsg:
+		// Keep the fish swimming while the tank is on screen
+		if m.state == "aquarium" {
+			m.aquariumFrame++
+			return m, aquariumTick()
+		}
+		m.aquariumTicking = false
+		return m, nil
+	case lootTickMsg:
+		// Step the reveal until every reward is showing
+		if m.state == "fishResult" && len(m.loot) > 0 && !revealDone(m.caughtFish.Name, m.loot, m.lootFrame) {
+			m.lootFrame++
+			return m, lootTick()
+		}
+		return m, nil
+	case expeditionTickMsg:
+		// Keep the timer running until the boat is home
+		if checkExpedition() {
+			return m, expeditionTick()
+		}
+		return m, nil
+	case buffTickMsg:
+		// Keep redrawing the countdowns until every buff wears off
+		mu.Lock()
+		active := len(currentBuffs()) > 0
+		mu.Unlock()
+		if active {
+			return m, buffTick()
+		}
+		return m, nil
+	case chumTickMsg:
+		// Keep redrawing the countdown until the chum wears off
+		if _, ok := currentChum(); ok {
+			return m, chumTick()
+		}
+		return m, nil
 	case autoContinueMsg:
 		// Auto-continue after showing the result for a moment
 		if m.state == "fishResult" && autoFishing {
+			if tournament != nil && tournament.IsOver(time.Now()) {
+				return m.finishTournament()
+			}
+
 			// Show fishing animation after catch result
 			m.state = "fishing"
 			m.fishingState = 0
@@ -316,20 +539,44 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 	case catchResultMsg:
+		m.lineSnapped = msg.snapped
+		m.leveledUp = msg.leveledUp
 		if msg.success {
 			m.catchSuccess = true
 			m.caughtFish = msg.fish
 			m.fishShape = fishShapes[rand.Intn(len(fishShapes))]
 		} else {
 			m.catchSuccess = false
+			if msg.snapped {
+				// Keep the one that got away for the result screen
+				m.caughtFish = msg.fish
+			}
 		}
 		m.state = "fishResult"
 		updateCurrentUIState("fishResult")
 		m.resultTimer = 0
 
+		// Pop the combo counter
+		m.comboFrame = 0
+		m.comboLost = msg.comboLost
+		cmds := []tea.Cmd{comboTick()}
+
+		// Open up any container that was landed
+		m.loot = msg.loot
+		m.lootFrame = 0
+		if len(m.loot) > 0 {
+			cmds = append(cmds, lootTick())
+		}
+
 		// If auto-fishing is enabled, automatically continue after showing results
 		if autoFishing {
-			return m, autoContinue()
+			cmds = append(cmds, autoContinue())
+		}
+		return m, tea.Batch(cmds...)
+	case comboTickMsg:
+		if m.state == "fishResult" && m.comboFrame < comboFrames {
+			m.comboFrame++
+			return m, comboTick()
 		}
 		return m, nil
 	}
@@ -339,28 +586,92 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // Handle completion of fishing and determine catch
 func (m model) completeFishing() (tea.Model, tea.Cmd) {
+	// A worn-out rod can't be cast until it has been repaired
+	if player.IsRodBroken() {
+		autoFishing = false
+		withdrawFromTournament()
+		m.state = "menu"
+		m.message = "Your rod is broken! Visit the shop to repair it."
+		updateCurrentUIState("menu")
+		return m, nil
+	}
+
+	// Use up one piece of bait - without any we're fishing with a bare hook
+	mu.Lock()
+	bait, _ := player.UseBait()
+	tier := player.LaunchBoat() // Fuel for the boat, if we're out in one
+	mu.Unlock()
+
 	// Calculate catch chance with weather and time of day factors
-	catchChance := (rand.Float64() * 10) + float64(player.RodStrength) + float64(player.BaitStrength)
+	catchChance := (rand.Float64() * 10) + float64(player.RodStrength) + float64(bait.Strength)
 	catchChance *= weatherFactor
-	catchChance *= timeFactor // Apply time of day factor
+	catchChance *= timeFactor      // Apply time of day factor
+	catchChance *= chumBiteBoost() // Chum gets fish biting
+	catchChance *= 1 + buffStrength("catch_chance")
+	catchChance *= eventCatchFactor()
 
 	success := catchChance >= 5
+	snapped := false
+	leveledUp := false
+	comboLost := 0
+	var loot []game.LootDrop
 
 	var fish game.Fish
 	if success {
-		// Choose a fish based on rarity and time of day
-		fish = chooseFish()
-		// Add to inventory
-		player.AddFish(fish)
-		// Auto-save when a fish is caught
+		// Choose a fish based on rarity, time of day and bait
+		fish = chooseFish(bait, tier)
+
+		// Legendary creatures have to be fought before they can be landed.
+		// Auto-fishing has nobody at the rod to fight them, so it lands
+		// them the old way
+		if fish.IsLegendary && !autoFishing {
+			return m.startEncounter(fish)
+		}
+
+		// Reel it in - heavy fish can snap the line. Catches in a row are
+		// worth more, and a snapped line or trash ends the combo.
+		mu.Lock()
+		levelBefore := player.Level()
+		comboBefore := player.Combo.Count
+		fish = player.WithCombo(fish).CaughtAt(player.Location(), time.Now())
+		landed, opened := landFish(fish)
+		loot = opened
+		if !landed {
+			success = false
+			snapped = true
+			comboLost = player.BreakCombo()
+		} else {
+			player.ExtendCombo(fish)
+			player.AddXP(player.ComboXP(fish))
+			if fish.IsTrash {
+				comboLost = comboBefore
+			}
+			if tournament != nil && !fish.IsTrash {
+				tournament.AddPlayerCatch(fish, time.Now())
+			}
+		}
+		leveledUp = player.Level() > levelBefore
+		mu.Unlock()
+
+		// Auto-save when a fish is hooked
 		saveGameProgress()
+	} else {
+		// Even an empty cast wears the rod a little, and ends the combo
+		mu.Lock()
+		player.WearRod(0)
+		comboLost = player.BreakCombo()
+		mu.Unlock()
 	}
 
 	// Return catch result
 	return m, func() tea.Msg {
 		return catchResultMsg{
-			success: success,
-			fish:    fish,
+			success:   success,
+			snapped:   snapped,
+			fish:      fish,
+			leveledUp: leveledUp,
+			loot:      loot,
+			comboLost: comboLost,
 		}
 	}
 }
@@ -381,6 +692,10 @@ func (m model) updateMenu(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "enter", " ":
 		switch m.selectedItem {
 		case 0: // Go Fishing
+			if player.IsRodBroken() {
+				m.message = "Your rod is broken! Visit the shop to repair it."
+				return m, nil
+			}
 			m.state = "fishing"
 			m.fishingState = 0
 			m.message = ""
@@ -406,10 +721,67 @@ func (m model) updateMenu(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			} else {
 				m.historyViewingDate = ""
 			}
-		case 3: // Quit
+		case 3: // Visit Shop
+			m.state = "shop"
+			m.shopCursor = 0
+			m.message = ""
+		case 4: // Profile
+			m.state = "profile"
+			m.skillCursor = 0
+			m.message = ""
+		case 5: // Achievements
+			m.state = "achievements"
+			m.message = ""
+		case 6: // Fishdex
+			m.state = "fishdex"
+			m.message = ""
+		case 7: // Quest Board
+			// Make sure the board isn't showing yesterday's quests
+			mu.Lock()
+			player.RefreshQuests(time.Now())
+			mu.Unlock()
+			m.state = "quests"
+			m.message = ""
+		case 8: // Aquarium
+			return m.openAquarium()
+		case 9: // Crafting
+			m.state = "crafting"
+			m.craftCursor = 0
+			m.message = ""
+		case 10: // Kitchen
+			m.state = "kitchen"
+			m.kitchenCursor = 0
+			m.message = ""
+		case 11: // Tournament
+			m.state = "tournament"
+			m.message = ""
+		case 12: // Expeditions
+			m.state = "expeditions"
+			m.message = ""
+		case 13: // Fishing Lines
+			return m.openLines()
+		case 14: // Fishing Rules
+			return m.openRegulations()
+		case 15: // Fish Stocks
+			return m.openPopulations()
+		case 16: // Festivals
+			return m.openFestivals()
+		case 17: // Fish Farm
+			return m.openFarm()
+		case 18: // Quit
 			close(stopIdle) // Safely close channel
 			return m, tea.Quit
 		}
+	case "l": // Check on the auto-fishing lines
+		return m.openLines()
+	case "r": // Read the local fishing rules
+		return m.openRegulations()
+	case "p": // Check on the fish stocks
+		return m.openPopulations()
+	case "f": // See when the festivals are
+		return m.openFestivals()
+	case "h": // Look after the fish farm
+		return m.openFarm()
 	case "a": // Toggle auto-fishing with 'a' key from anywhere in the menu
 		autoFishing = !autoFishing
 		if autoFishing {
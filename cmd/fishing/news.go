@@ -0,0 +1,160 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/user/fishing-game/game"
+)
+
+// Most headline IDs remembered, so old stories don't start events again
+const maxNewsSeen = 500
+
+// newsInterval returns how often the news feed is checked
+func newsInterval() time.Duration {
+	if testMode {
+		return time.Minute
+	}
+	return 15 * time.Minute
+}
+
+// loadNewsRules reads the keyword rules from a JSON file, or returns the
+// default rules if no file was given
+func loadNewsRules(path string) ([]game.NewsRule, error) {
+	if path == "" {
+		return game.GetDefaultNewsRules(), nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []game.NewsRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+	if err := game.ValidateNewsRules(rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// readNewsFeed fetches the feed from a URL or reads it from a file
+func readNewsFeed(source string) ([]byte, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return ioutil.ReadFile(source)
+	}
+
+	client := http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Get(source)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("feed returned %s", resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// hasSeenNews reports whether a headline was already read from the feed.
+// Callers must hold mu.
+func hasSeenNews(id string) bool {
+	for _, seen := range newsSeen {
+		if seen == id {
+			return true
+		}
+	}
+	return false
+}
+
+// checkNews reads the feed and starts an event for every fresh headline
+// that matches a rule, with the headline as the event's description.
+// Returns whether anything changed.
+func checkNews() bool {
+	data, err := readNewsFeed(newsFeed)
+	var headlines []game.Headline
+	if err == nil {
+		headlines, err = game.ParseFeed(data)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// Only mention a problem with the feed once, until it changes
+	if err != nil {
+		if err.Error() != newsError {
+			newsError = err.Error()
+			showBanner("📰 Couldn't read the news: " + newsError)
+		}
+		return false
+	}
+	newsError = ""
+
+	now := time.Now()
+	changed := false
+	for _, headline := range headlines {
+		if hasSeenNews(headline.ID) {
+			continue
+		}
+		newsSeen = append(newsSeen, headline.ID)
+		changed = true
+
+		if !headline.IsFresh(now) {
+			continue
+		}
+		event, ok := game.MatchHeadline(newsRules, headline)
+		if !ok || isEventRunning(event.Name) {
+			continue
+		}
+
+		occurrence := event.Start(now)
+		occurrence.Description = headline.Title
+		worldEvents = append(worldEvents, occurrence)
+	}
+
+	if len(newsSeen) > maxNewsSeen {
+		newsSeen = newsSeen[len(newsSeen)-maxNewsSeen:]
+	}
+
+	noteEvents(currentEvents())
+	return changed
+}
+
+// isEventRunning reports whether a world event is going on right now.
+// Callers must hold mu.
+func isEventRunning(name string) bool {
+	for _, occurrence := range currentEvents() {
+		if occurrence.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// startNewsRoutine checks the news feed now and then for as long as the game runs
+func startNewsRoutine() {
+	if checkNews() {
+		saveGameProgress()
+	}
+
+	ticker := time.NewTicker(newsInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if checkNews() {
+				saveGameProgress()
+			}
+		case <-stopIdle:
+			return
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/user/fishing-game/game"
+)
+
+func (m model) updateProfile(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	skills := game.GetAllSkills()
+
+	switch msg.String() {
+	case "q", "esc":
+		m.state = "menu"
+		m.message = ""
+		updateCurrentUIState("menu")
+	case "up", "k":
+		if m.skillCursor > 0 {
+			m.skillCursor--
+		}
+	case "down", "j":
+		if m.skillCursor < len(skills)-1 {
+			m.skillCursor++
+		}
+	case "enter", " ":
+		skill := skills[m.skillCursor]
+		mu.Lock()
+		learned := player.LearnSkill(skill.ID)
+		rank := player.SkillRank(skill.ID)
+		mu.Unlock()
+
+		if learned {
+			m.message = fmt.Sprintf("%s is now rank %d/%d!", skill.Name, rank, skill.MaxRank)
+			saveGameProgress()
+		} else if rank >= skill.MaxRank {
+			m.message = fmt.Sprintf("%s is already maxed out.", skill.Name)
+		} else if skill.Requires != "" && player.SkillRank(skill.Requires) == 0 {
+			required, _ := game.GetSkillByID(skill.Requires)
+			m.message = fmt.Sprintf("Learn %s first.", required.Name)
+		} else {
+			m.message = "No skill points left. Level up by catching fish!"
+		}
+	case "s": // Save progress
+		saveGameProgress()
+		m.message = "Game progress saved."
+	}
+	return m, nil
+}
+
+func (m model) renderProfile() string {
+	content := strings.Builder{}
+
+	content.WriteString(historyHeaderStyle.Render("ANGLER PROFILE") + "\n\n")
+
+	// Level and progress to the next one
+	level := player.Level()
+	levelStart := game.XPForLevel(level)
+	levelEnd := game.XPForLevel(level + 1)
+	progress := float64(player.XP-levelStart) / float64(levelEnd-levelStart)
+
+	barWidth := 20
+	if m.width < 60 {
+		barWidth = 10
+	}
+	blocks := int(float64(barWidth) * progress)
+	xpBar := "[" + strings.Repeat("█", blocks) + strings.Repeat("░", barWidth-blocks) + "]"
+
+	content.WriteString(successStyle.Render(fmt.Sprintf("Level %d", level)) + "\n")
+	content.WriteString(infoStyle.Render(fmt.Sprintf("%s %d/%d XP", xpBar, player.XP-levelStart, levelEnd-levelStart)) + "\n")
+	if m.width >= 50 {
+		content.WriteString(fmt.Sprintf("Money: $%d | Rod: %s | Bait: %s\n", player.Money, player.FishingRod, player.Bait))
+	}
+	conservation := fmt.Sprintf("Conservation: %d (%d released)", player.Conservation, player.Released)
+	if title := player.ConservationTitle(); title != "" {
+		conservation += " - " + title
+	}
+	content.WriteString(infoStyle.Render(conservation) + "\n")
+	content.WriteString(infoStyle.Render(fmt.Sprintf("Best combo: x%d | Current combo: x%d", player.BestCombo, player.Combo.Count)) + "\n")
+	content.WriteString("\n")
+
+	// Skill tree, with child skills indented under their prerequisite
+	content.WriteString(accentStyle.Render(fmt.Sprintf("SKILLS (%d points to spend)", player.SkillPoints())) + "\n")
+	for i, skill := range game.GetAllSkills() {
+		indent := ""
+		if skill.Requires != "" {
+			indent = "└ "
+		}
+
+		rank := player.SkillRank(skill.ID)
+		line := fmt.Sprintf("%s%s %d/%d", indent, skill.Name, rank, skill.MaxRank)
+		if m.width >= 60 {
+			line = fmt.Sprintf("%-24s %s", line, skill.Description)
+		}
+
+		if i == m.skillCursor {
+			content.WriteString(highlightedMenuItemStyle.Render(line))
+		} else if player.CanLearnSkill(skill) {
+			// Skills that can take a point right now stand out in green
+			content.WriteString(menuItemStyle.Foreground(lipgloss.Color("#98C379")).Render(line))
+		} else {
+			content.WriteString(menuItemStyle.Render(line))
+		}
+		content.WriteString("\n")
+	}
+
+	return boxStyle.Render(content.String())
+}
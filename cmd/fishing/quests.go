@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/fishing-game/game"
+)
+
+func (m model) updateQuests(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc", "enter":
+		m.state = "menu"
+		updateCurrentUIState("menu")
+	}
+	return m, nil
+}
+
+// renderQuestList draws one section of the quest board
+func (m model) renderQuestList(quests []game.Quest) string {
+	content := strings.Builder{}
+
+	barWidth := 15
+	if m.width < 60 {
+		barWidth = 8
+	}
+
+	for _, quest := range quests {
+		if quest.Completed {
+			content.WriteString(successStyle.Render("✓ "+quest.Description) + "\n")
+			continue
+		}
+
+		blocks := barWidth * quest.Progress / quest.Goal
+		bar := "[" + strings.Repeat("█", blocks) + strings.Repeat("░", barWidth-blocks) + "]"
+
+		content.WriteString("  " + quest.Description + "\n")
+		content.WriteString(infoStyle.Render(fmt.Sprintf("  %s %d/%d  Reward: $%d, %d XP",
+			bar, quest.Progress, quest.Goal, quest.RewardMoney, quest.RewardXP)) + "\n")
+	}
+
+	return content.String()
+}
+
+// formatTimeLeft formats a duration as hours and minutes
+func formatTimeLeft(d time.Duration) string {
+	hours := int(d.Hours())
+	if hours >= 24 {
+		return fmt.Sprintf("%dd %dh", hours/24, hours%24)
+	}
+	return fmt.Sprintf("%dh %dm", hours, int(d.Minutes())%60)
+}
+
+func (m model) renderQuests() string {
+	content := strings.Builder{}
+
+	content.WriteString(historyHeaderStyle.Render("QUEST BOARD") + "\n\n")
+
+	// Quests reroll at local midnight and at the start of each ISO week (Monday)
+	now := time.Now()
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
+	daysToMonday := (8 - int(now.Weekday())) % 7
+	if daysToMonday == 0 {
+		daysToMonday = 7
+	}
+	nextWeek := time.Date(now.Year(), now.Month(), now.Day()+daysToMonday, 0, 0, 0, 0, now.Location())
+
+	content.WriteString(accentStyle.Render("DAILY") + infoStyle.Render(" - new quests in "+formatTimeLeft(tomorrow.Sub(now))) + "\n")
+	content.WriteString(m.renderQuestList(player.Quests.Daily) + "\n")
+
+	content.WriteString(accentStyle.Render("WEEKLY") + infoStyle.Render(" - new quests in "+formatTimeLeft(nextWeek.Sub(now))) + "\n")
+	content.WriteString(m.renderQuestList(player.Quests.Weekly))
+
+	return boxStyle.Render(content.String())
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/fishing-game/game"
+)
+
+// Chance of a warden turning up to inspect the catch each time a fish is landed
+const wardenChance = 0.05
+
+// keptToday counts the fish of a species caught at a location today and not
+// released again, from today's catch log. Bag limits are set per location,
+// and fish caught on earlier days don't count towards today's bag.
+func keptToday(name, location string) int {
+	today := time.Now().Format("2006-01-02")
+
+	kept := 0
+	for _, fish := range dailyCatches[today] {
+		if fish.Name == name && fish.Catch.Location == location {
+			kept++
+		}
+	}
+	for _, release := range dailyReleases[today] {
+		caught := release.Fish.Catch
+		if release.Fish.Name == name && caught.Location == location && caught.Time.Format("2006-01-02") == today {
+			kept--
+		}
+	}
+	return kept
+}
+
+// checkRegulations flags a freshly landed fish that breaks the rules where it
+// was caught. Callers must hold mu.
+func checkRegulations(fish game.Fish) []game.Violation {
+	if !regulationsOn {
+		return nil
+	}
+
+	location := fish.Catch.Location
+	regulation, ok := game.GetRegulation(location)
+	if !ok {
+		return nil
+	}
+
+	violations := regulation.Check(fish, keptToday(fish.Name, location), player.HasLicense(regulation.License, time.Now()))
+	if len(violations) > 0 {
+		player.FlagIllegal(fish, location, violations)
+	}
+	return violations
+}
+
+// wardenInspection has a warden check the inventory for illegal fish,
+// announcing any fine. Callers must hold mu.
+func wardenInspection() {
+	if len(player.Illegal) == 0 {
+		return
+	}
+
+	fine, confiscated := player.Inspect()
+	if len(confiscated) == 0 {
+		return
+	}
+
+	showBanner(fmt.Sprintf("👮 A warden inspected your catch! %d illegal fish confiscated and fined $%d", len(confiscated), fine))
+}
+
+func (m model) updateRegulations(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	regulations := game.GetAllRegulations()
+
+	switch msg.String() {
+	case "q", "esc":
+		m.state = "menu"
+		updateCurrentUIState("menu")
+	case "left", "h":
+		if m.regulationCursor > 0 {
+			m.regulationCursor--
+		}
+	case "right", "l":
+		if m.regulationCursor < len(regulations)-1 {
+			m.regulationCursor++
+		}
+	case "e":
+		mu.Lock()
+		regulationsOn = !regulationsOn
+		if regulationsOn {
+			m.message = "Regulations are on. Mind the rules, the wardens are watching!"
+		} else {
+			m.message = "Regulations are off. Keep whatever you catch."
+		}
+		mu.Unlock()
+		saveGameProgress()
+	}
+	return m, nil
+}
+
+// openRegulations shows the rules of wherever the player is fishing from
+func (m model) openRegulations() (tea.Model, tea.Cmd) {
+	m.state = "regulations"
+	m.message = ""
+	m.regulationCursor = 0
+	for i, regulation := range game.GetAllRegulations() {
+		if regulation.Location == player.Location() {
+			m.regulationCursor = i
+		}
+	}
+	updateCurrentUIState("regulations")
+	return m, nil
+}
+
+// sortedSpecies returns the species named in a map of limits, sorted by name
+func sortedSpecies(limits map[string]int) []string {
+	names := make([]string, 0, len(limits))
+	for name := range limits {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (m model) renderRegulations() string {
+	content := strings.Builder{}
+	now := time.Now()
+
+	regulations := game.GetAllRegulations()
+	regulation := regulations[m.regulationCursor]
+
+	content.WriteString(historyHeaderStyle.Render("FISHING REGULATIONS") + "\n\n")
+	if regulationsOn {
+		content.WriteString(successStyle.Render("Regulations are enforced") + "\n\n")
+	} else {
+		content.WriteString(infoStyle.Render("Regulations are off. Press e to fish by the rules.") + "\n\n")
+	}
+
+	// Tabs for every regulated location
+	tabs := []string{}
+	for i, r := range regulations {
+		if i == m.regulationCursor {
+			tabs = append(tabs, highlightedMenuItemStyle.Render(r.Location))
+		} else {
+			tabs = append(tabs, r.Location)
+		}
+	}
+	content.WriteString(strings.Join(tabs, "  ") + "\n")
+	if regulation.Location == player.Location() {
+		content.WriteString(infoStyle.Render("You're fishing here") + "\n")
+	} else if _, ok := game.GetRegulation(player.Location()); !ok {
+		content.WriteString(infoStyle.Render(fmt.Sprintf("You're fishing at the %s, which has no rules at all", player.Location())) + "\n")
+	}
+	content.WriteString("\n")
+
+	// License needed and whether it's still valid
+	if regulation.License != "" {
+		if player.HasLicense(regulation.License, now) {
+			content.WriteString(successStyle.Render(fmt.Sprintf("%s valid until %s", regulation.License,
+				player.Licenses[regulation.License].Format("Jan 2 15:04"))) + "\n\n")
+		} else {
+			license, _ := game.GetLicenseByName(regulation.License)
+			content.WriteString(errorStyle.Render(fmt.Sprintf("You need a %s ($%d at the shop)", license.Name, license.Cost)) + "\n\n")
+		}
+	}
+
+	if len(regulation.MinSizes) > 0 {
+		content.WriteString(accentStyle.Render("MINIMUM SIZES") + "\n")
+		for _, name := range sortedSpecies(regulation.MinSizes) {
+			content.WriteString(fmt.Sprintf("  %-16s %d lbs\n", name, regulation.MinSizes[name]))
+		}
+	}
+	if len(regulation.BagLimits) > 0 {
+		content.WriteString(accentStyle.Render("DAILY BAG LIMITS") + "\n")
+		for _, name := range sortedSpecies(regulation.BagLimits) {
+			content.WriteString(fmt.Sprintf("  %-16s %d a day (%d kept today)\n", name, regulation.BagLimits[name], keptToday(name, regulation.Location)))
+		}
+	}
+	if len(regulation.Protected) > 0 {
+		content.WriteString(accentStyle.Render("PROTECTED") + "\n")
+		content.WriteString("  " + strings.Join(regulation.Protected, ", ") + "\n")
+	}
+
+	// Illegal fish still waiting in the inventory
+	if illegal := player.IllegalKept(); len(illegal) > 0 {
+		fines := 0
+		for _, catch := range illegal {
+			fines += catch.Fine()
+		}
+		content.WriteString("\n" + errorStyle.Render(fmt.Sprintf("⚠ %d illegal fish in your inventory (up to $%d in fines)",
+			len(illegal), fines)) + "\n")
+		content.WriteString(infoStyle.Render("Release them before a warden comes by!"))
+	} else {
+		content.WriteString("\n" + successStyle.Render("No illegal fish in your inventory."))
+	}
+
+	return boxStyle.Render(content.String())
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/fishing-game/game"
+)
+
+// logRelease records a release in today's history, restocks the waters the
+// fish was caught in, announces any rewards it unlocked and returns a message
+// describing it. Callers must hold mu.
+func logRelease(release game.Release, rewards []game.ConservationReward) string {
+	today := release.Time.Format("2006-01-02")
+	dailyReleases[today] = append(dailyReleases[today], release)
+
+	// Fish from older saves don't know where they were caught
+	location := release.Fish.Catch.Location
+	if location == "" {
+		location = player.Location()
+	}
+	player.Restock(location, release.Fish, release.Time)
+	if !contains(dateList, today) {
+		dateList = append(dateList, today)
+	}
+
+	for _, reward := range rewards {
+		gift := []string{}
+		if reward.Money > 0 {
+			gift = append(gift, fmt.Sprintf("$%d", reward.Money))
+		}
+		if reward.Bait != "" {
+			gift = append(gift, fmt.Sprintf("%d %s", reward.BaitCount, reward.Bait))
+		}
+		showBanner(fmt.Sprintf("🌿 You're now a %s! (+%s)", reward.Title, strings.Join(gift, ", ")))
+	}
+
+	message := fmt.Sprintf("You let the %s (%d lbs) go. +%d conservation", release.Fish.Name, release.Fish.Weight, release.Points)
+	if game.IsUndersized(release.Fish) {
+		message += " - it has some growing to do!"
+	}
+	return message
+}
+
+// canRelease reports whether the catch on the result screen can be let go
+func (m model) canRelease() bool {
+	return m.catchSuccess && len(m.loot) == 0 && !m.caughtFish.IsTrash
+}
+
+// releaseCatch lets go of the fish that was just landed
+func releaseCatch(fish game.Fish) (string, bool) {
+	mu.Lock()
+	release, rewards, ok := player.ReleaseLatest(fish, time.Now())
+	message := ""
+	if ok {
+		message = logRelease(release, rewards)
+	}
+	mu.Unlock()
+
+	if ok {
+		saveGameProgress()
+	}
+	return message, ok
+}
+
+func (m model) updateRelease(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	mu.Lock()
+	candidates := aquariumCandidates()
+	switch msg.String() {
+	case "q", "esc":
+		m.state = "inventory"
+		updateCurrentUIState("inventory")
+	case "up", "k":
+		if m.releaseCursor > 0 {
+			m.releaseCursor--
+		}
+	case "down", "j":
+		if m.releaseCursor < len(candidates)-1 {
+			m.releaseCursor++
+		}
+	case "enter", " ", "x":
+		if m.releaseCursor >= len(candidates) {
+			mu.Unlock()
+			return m, nil
+		}
+		release, rewards, _ := player.ReleaseFish(candidates[m.releaseCursor], time.Now())
+		m.message = logRelease(release, rewards)
+		if m.releaseCursor >= len(candidates)-1 && m.releaseCursor > 0 {
+			m.releaseCursor--
+		}
+		mu.Unlock()
+
+		saveGameProgress()
+		return m, nil
+	}
+	mu.Unlock()
+	return m, nil
+}
+
+func (m model) renderRelease() string {
+	content := strings.Builder{}
+
+	content.WriteString(historyHeaderStyle.Render("CATCH AND RELEASE") + "\n\n")
+	content.WriteString(fmt.Sprintf("Conservation score: %s", successStyle.Render(fmt.Sprintf("%d", player.Conservation))))
+	if title := player.ConservationTitle(); title != "" {
+		content.WriteString(" - " + accentStyle.Render(title))
+	}
+	content.WriteString("\n")
+	if next, ok := player.NextConservationReward(); ok {
+		content.WriteString(infoStyle.Render(fmt.Sprintf("Next: %s at %d points", next.Title, next.Score)) + "\n")
+	}
+	content.WriteString("\n")
+
+	candidates := aquariumCandidates()
+	if len(candidates) == 0 {
+		content.WriteString("You have no fish to release.")
+		return boxStyle.Render(content.String())
+	}
+
+	// Show a page of the inventory around the cursor
+	start := m.releaseCursor - m.itemsPerPage/2
+	if start > len(candidates)-m.itemsPerPage {
+		start = len(candidates) - m.itemsPerPage
+	}
+	if start < 0 {
+		start = 0
+	}
+	end := start + m.itemsPerPage
+	if end > len(candidates) {
+		end = len(candidates)
+	}
+
+	for i := start; i < end; i++ {
+		fish := player.FishCaught[candidates[i]]
+		line := fmt.Sprintf("%s (%d lbs) +%d", fish.Name, fish.Weight, game.ReleasePoints(fish))
+		if game.IsUndersized(fish) {
+			line += " undersized"
+		}
+		if i == m.releaseCursor {
+			content.WriteString(highlightedMenuItemStyle.Render("> "+line) + "\n")
+		} else {
+			content.WriteString("  " + line + "\n")
+		}
+	}
+	content.WriteString("\n" + infoStyle.Render(fmt.Sprintf("%d of %d fish | Undersized and rare fish earn the most", m.releaseCursor+1, len(candidates))))
+
+	return boxStyle.Render(content.String())
+}
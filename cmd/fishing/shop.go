@@ -0,0 +1,285 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/fishing-game/game"
+)
+
+// shopItem is a single line in the shop listing
+type shopItem struct {
+	Kind  string // "sell", "repair", "rod", "holder", "boat", "license", "bait" or "chum"
+	Name  string
+	Price int
+}
+
+// getShopItems builds the shop listing from the player's current state
+func getShopItems() []shopItem {
+	items := []shopItem{
+		{Kind: "sell", Name: "Sell all fish", Price: game.SalePrice(player.TotalValue, eventPriceFactor())},
+		{Kind: "repair", Name: "Repair " + player.FishingRod, Price: player.RepairCost()},
+	}
+
+	for _, rod := range game.GetAllRods() {
+		// Everyone starts with the free rod, so it's only offered to anglers
+		// stuck with a broken rod they can't afford to fix
+		if rod.Cost == 0 && (!player.IsRodBroken() || player.Money >= player.RepairCost()) {
+			continue
+		}
+		items = append(items, shopItem{Kind: "rod", Name: rod.Name, Price: rod.Cost})
+	}
+
+	if cost, ok := player.NextRodHolderCost(); ok {
+		items = append(items, shopItem{Kind: "holder", Name: "Rod Holder", Price: cost})
+	}
+
+	for _, boat := range game.GetAllBoats() {
+		items = append(items, shopItem{Kind: "boat", Name: boat.Name, Price: boat.Cost})
+	}
+
+	for _, license := range game.GetAllLicenses() {
+		items = append(items, shopItem{Kind: "license", Name: license.Name, Price: license.Cost})
+	}
+
+	for _, bait := range game.GetAllBait() {
+		items = append(items, shopItem{Kind: "bait", Name: bait.Name, Price: bait.Cost})
+	}
+
+	for _, chum := range game.GetAllChum() {
+		items = append(items, shopItem{Kind: "chum", Name: chum.Name, Price: chum.Cost})
+	}
+
+	return items
+}
+
+func (m model) updateShop(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	items := getShopItems()
+
+	switch msg.String() {
+	case "q", "esc":
+		m.state = "menu"
+		m.message = ""
+		updateCurrentUIState("menu")
+	case "up", "k":
+		if m.shopCursor > 0 {
+			m.shopCursor--
+		}
+	case "down", "j":
+		if m.shopCursor < len(items)-1 {
+			m.shopCursor++
+		}
+	case "enter", " ":
+		if m.shopCursor < len(items) {
+			m.message = buyShopItem(items[m.shopCursor])
+		}
+	case "e": // Equip the selected bait
+		if m.shopCursor < len(items) && items[m.shopCursor].Kind == "bait" {
+			name := items[m.shopCursor].Name
+			mu.Lock()
+			if player.EquipBait(name) {
+				m.message = fmt.Sprintf("Now fishing with %s.", name)
+			} else {
+				m.message = fmt.Sprintf("You don't have any %s.", name)
+			}
+			mu.Unlock()
+		}
+	case "s": // Save progress
+		saveGameProgress()
+		m.message = "Game progress saved."
+	}
+	return m, nil
+}
+
+// buyShopItem performs the purchase and returns a message describing the outcome
+func buyShopItem(item shopItem) string {
+	mu.Lock()
+	var result string
+	changed := false
+
+	switch item.Kind {
+	case "sell":
+		if len(player.FishCaught) == 0 {
+			result = "You have no fish to sell."
+		} else {
+			earned := player.SellAllFish(eventPriceFactor())
+			result = fmt.Sprintf("Sold your catch for $%d!", earned)
+			announceAchievements(player.CheckAchievements(time.Now()))
+			player.RefreshQuests(time.Now())
+			announceQuests(player.QuestSale(earned))
+			changed = true
+		}
+	case "repair":
+		cost := player.RepairCost()
+		if player.RodWear == 0 {
+			result = fmt.Sprintf("Your %s is already in perfect condition.", player.FishingRod)
+		} else if !player.RepairRod() {
+			result = fmt.Sprintf("You need $%d to repair your %s.", cost, player.FishingRod)
+		} else {
+			result = fmt.Sprintf("Your %s is good as new! (-$%d)", player.FishingRod, cost)
+			changed = true
+		}
+	case "rod":
+		rod := game.GetRodByName(item.Name)
+		if player.FishingRod == rod.Name {
+			result = fmt.Sprintf("You already own the %s.", rod.Name)
+		} else if !player.BuyRod(rod.Name, rod.Cost, rod.Strength) {
+			result = fmt.Sprintf("You need $%d for the %s.", rod.Cost, rod.Name)
+		} else {
+			result = fmt.Sprintf("You bought the %s! Its line holds up to %d lbs.", rod.Name, rod.MaxWeight)
+			changed = true
+		}
+	case "holder":
+		if !player.BuyRodHolder() {
+			result = fmt.Sprintf("You need $%d for a %s.", item.Price, item.Name)
+		} else {
+			result = fmt.Sprintf("Set up a %s! You have %d lines out. Press 'l' in the menu to check on them.", item.Name, player.LineCount())
+			changed = true
+		}
+	case "boat":
+		boat, _ := game.GetBoatByName(item.Name)
+		if player.BoatAway(boat.Name) {
+			result = fmt.Sprintf("Your %s is away on an expedition.", boat.Name)
+		} else if player.OwnsBoat(boat.Name) {
+			player.UseBoat(boat.Name)
+			result = fmt.Sprintf("You take your %s out %s.", boat.Name, boat.Location)
+			changed = true
+		} else if !player.BuyBoat(boat.Name) {
+			result = fmt.Sprintf("You need $%d for the %s.", boat.Cost, boat.Name)
+		} else {
+			result = fmt.Sprintf("You bought the %s! Press 'v' while fishing to switch boats.", boat.Name)
+			changed = true
+		}
+	case "license":
+		if !player.BuyLicense(item.Name, time.Now()) {
+			result = fmt.Sprintf("You need $%d for a %s.", item.Price, item.Name)
+		} else {
+			result = fmt.Sprintf("Bought a %s. It's valid until %s.", item.Name, player.Licenses[item.Name].Format("Jan 2 15:04"))
+			changed = true
+		}
+	case "bait":
+		bait, _ := game.GetBaitByName(item.Name)
+		if !player.BuyBait(bait.Name, bait.Cost, bait.PackSize) {
+			result = fmt.Sprintf("You need $%d for a pack of %s.", bait.Cost, bait.Name)
+		} else {
+			result = fmt.Sprintf("Bought %d %s. You have %d now.", bait.PackSize, bait.Name, player.BaitStock[bait.Name])
+			changed = true
+		}
+	case "chum":
+		chum, _ := game.GetChumByName(item.Name)
+		if !player.BuyChum(chum.Name, chum.Cost) {
+			result = fmt.Sprintf("You need $%d for a %s.", chum.Cost, chum.Name)
+		} else {
+			result = fmt.Sprintf("Bought a %s. Press 'c' while fishing to throw it.", chum.Name)
+			changed = true
+		}
+	}
+	mu.Unlock()
+
+	// Save right away so purchases aren't lost
+	if changed {
+		saveGameProgress()
+	}
+	return result
+}
+
+func (m model) renderShop() string {
+	content := strings.Builder{}
+
+	content.WriteString(historyHeaderStyle.Render("TACKLE SHOP") + "\n\n")
+
+	// Current money and gear condition
+	rod := player.Rod()
+	content.WriteString(fmt.Sprintf("Money: %s\n", successStyle.Render(fmt.Sprintf("$%d", player.Money))))
+	condition := fmt.Sprintf("Rod: %s (%d/%d durability, line rated %d lbs)",
+		rod.Name, player.RodDurability(), rod.MaxDurability, rod.MaxWeight)
+	if player.IsRodBroken() {
+		content.WriteString(errorStyle.Render(condition+" - BROKEN") + "\n")
+	} else {
+		content.WriteString(infoStyle.Render(condition) + "\n")
+	}
+	content.WriteString(infoStyle.Render(fmt.Sprintf("Bait: %s (%d left)", player.Bait, player.BaitLeft())) + "\n\n")
+
+	for i, item := range getShopItems() {
+		var line string
+		switch item.Kind {
+		case "sell":
+			line = fmt.Sprintf("%s (%d fish, +$%d)", item.Name, len(player.FishCaught), item.Price)
+			if factor := eventPriceFactor(); factor != 1 {
+				line += fmt.Sprintf(" - market prices x%.2f", factor)
+			}
+		case "repair":
+			line = fmt.Sprintf("%s ($%d)", item.Name, item.Price)
+		case "rod":
+			shopRod := game.GetRodByName(item.Name)
+			if m.width >= 60 {
+				line = fmt.Sprintf("%-18s $%-5d Str %d | Line %d lbs", shopRod.Name, shopRod.Cost, shopRod.Strength, shopRod.MaxWeight)
+			} else {
+				line = fmt.Sprintf("%s $%d", shopRod.Name, shopRod.Cost)
+			}
+			if shopRod.Name == player.FishingRod {
+				line += " (equipped)"
+			}
+		case "holder":
+			if m.width >= 60 {
+				line = fmt.Sprintf("%-18s $%-5d Another line off your rod for auto-fishing (%d/%d)", item.Name, item.Price, player.LineCount(), game.MaxLines)
+			} else {
+				line = fmt.Sprintf("%s $%d", item.Name, item.Price)
+			}
+		case "boat":
+			boat, _ := game.GetBoatByName(item.Name)
+			if m.width >= 60 {
+				line = fmt.Sprintf("%-18s $%-5d Fuel $%d | %s", boat.Name, boat.Cost, boat.FuelCost, boat.Description)
+			} else {
+				line = fmt.Sprintf("%s $%d", boat.Name, boat.Cost)
+			}
+			if boat.Name == player.Boat {
+				line += " (in use)"
+			} else if player.BoatAway(boat.Name) {
+				line += " (on expedition)"
+			} else if player.OwnsBoat(boat.Name) {
+				line += " (owned)"
+			}
+		case "license":
+			license, _ := game.GetLicenseByName(item.Name)
+			if m.width >= 60 {
+				line = fmt.Sprintf("%-18s $%-5d Valid for %d days", license.Name, license.Cost, int(license.Duration.Hours()/24))
+			} else {
+				line = fmt.Sprintf("%s $%d", license.Name, license.Cost)
+			}
+			if player.HasLicense(license.Name, time.Now()) {
+				line += " (until " + player.Licenses[license.Name].Format("Jan 2") + ")"
+			}
+		case "bait":
+			bait, _ := game.GetBaitByName(item.Name)
+			if m.width >= 60 {
+				line = fmt.Sprintf("%-18s $%-5d x%-3d %s", bait.Name, bait.Cost, bait.PackSize, bait.Description)
+			} else {
+				line = fmt.Sprintf("%s x%d $%d", bait.Name, bait.PackSize, bait.Cost)
+			}
+			line += fmt.Sprintf(" [%d]", player.BaitStock[bait.Name])
+			if bait.Name == player.Bait {
+				line += " (equipped)"
+			}
+		case "chum":
+			chum, _ := game.GetChumByName(item.Name)
+			if m.width >= 60 {
+				line = fmt.Sprintf("%-18s $%-5d %s", chum.Name, chum.Cost, chum.Description)
+			} else {
+				line = fmt.Sprintf("%s $%d", chum.Name, chum.Cost)
+			}
+			line += fmt.Sprintf(" [%d]", player.ChumStock[chum.Name])
+		}
+
+		if i == m.shopCursor {
+			content.WriteString(highlightedMenuItemStyle.Render(line))
+		} else {
+			content.WriteString(menuItemStyle.Render(line))
+		}
+		content.WriteString("\n")
+	}
+
+	return boxStyle.Render(content.String())
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/user/fishing-game/game"
+)
+
+// tournamentLength returns how long a tournament lasts
+func tournamentLength() time.Duration {
+	if testMode {
+		return 2 * time.Minute
+	}
+	return 10 * time.Minute
+}
+
+// tournamentSpeed scales the rivals to how quickly the player's casts resolve
+func tournamentSpeed() float64 {
+	if testMode {
+		// Casts take 5-10 seconds instead of 10-120
+		return 8
+	}
+	return 1
+}
+
+func (m model) updateTournament(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc":
+		m.state = "menu"
+		updateCurrentUIState("menu")
+	case "w", "v":
+		if player.IsRodBroken() {
+			m.message = "Your rod is broken! Visit the shop to repair it."
+			return m, nil
+		}
+
+		scoring := "weight"
+		if msg.String() == "v" {
+			scoring = "value"
+		}
+		tournament = game.NewTournament(scoring, tournamentLength(), tournamentSpeed(), time.Now())
+
+		m.message = fmt.Sprintf("The tournament has begun! Most %s in %d minutes wins.",
+			scoring, int(tournamentLength().Minutes()))
+		return m.startCast()
+	}
+	return m, nil
+}
+
+func (m model) updateTournamentResult(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc", "enter", " ":
+		m.finishedTournament = nil
+		m.state = "menu"
+		updateCurrentUIState("menu")
+	}
+	return m, nil
+}
+
+// startCast sends out a new line on the fishing screen
+func (m model) startCast() (tea.Model, tea.Cmd) {
+	m.state = "fishing"
+	m.fishingState = 0
+	// Set up a new random fishing duration
+	duration := getRandomFishingDuration()
+	m.fishingDuration = duration.Milliseconds()
+	m.fishingStarted = time.Now().UnixNano() / 1e6
+	m.fishingProgress = 0.0
+	updateCurrentUIState("fishing")
+	return m, tick()
+}
+
+// finishTournament ends the current tournament, pays out any prize and
+// shows the final standings
+func (m model) finishTournament() (tea.Model, tea.Cmd) {
+	trophy, won := tournament.Finish(time.Now())
+
+	mu.Lock()
+	if won {
+		player.Money += trophy.Prize
+		trophies = append(trophies, trophy)
+		showBanner(fmt.Sprintf("🏆 %s! You won $%d", trophy.Name, trophy.Prize))
+	}
+	mu.Unlock()
+
+	m.finishedTournament = tournament
+	tournament = nil
+	m.message = ""
+	m.state = "tournamentResult"
+	updateCurrentUIState("tournamentResult")
+
+	saveGameProgress()
+	return m, nil
+}
+
+// withdrawFromTournament abandons the current tournament without a prize
+func withdrawFromTournament() string {
+	if tournament == nil {
+		return ""
+	}
+	tournament = nil
+	return "You withdrew from the tournament."
+}
+
+// renderLeaderboard draws the standings of a tournament
+func renderLeaderboard(t *game.Tournament, width int) string {
+	content := strings.Builder{}
+
+	for i, entrant := range t.Standings() {
+		score := fmt.Sprintf("%d lbs", entrant.Score(t.Scoring))
+		if t.Scoring == "value" {
+			score = fmt.Sprintf("$%d", entrant.Score(t.Scoring))
+		}
+
+		line := fmt.Sprintf("%d. %-16s %8s", i+1, entrant.Name, score)
+		if width >= 60 {
+			line += fmt.Sprintf("  %2d fish", entrant.Catches)
+			if entrant.BestCatch != "" {
+				line += "  best: " + entrant.BestCatch
+			}
+		}
+
+		if entrant.IsPlayer {
+			content.WriteString(successStyle.Render(line) + "\n")
+		} else {
+			content.WriteString(line + "\n")
+		}
+	}
+
+	return content.String()
+}
+
+// renderTournamentStatus draws the live leaderboard shown while competing
+func renderTournamentStatus(width int) string {
+	remaining := tournament.Remaining(time.Now())
+	header := fmt.Sprintf("TOURNAMENT (%s) - %d:%02d left", strings.ToUpper(tournament.Scoring),
+		int(remaining.Minutes()), int(remaining.Seconds())%60)
+
+	return accentStyle.Render(header) + "\n" + renderLeaderboard(tournament, width)
+}
+
+func (m model) renderTournament() string {
+	content := strings.Builder{}
+
+	content.WriteString(historyHeaderStyle.Render("FISHING TOURNAMENT") + "\n\n")
+	content.WriteString(fmt.Sprintf("Fish for %d minutes against the locals. Top three win a trophy.\n",
+		int(tournamentLength().Minutes())))
+	content.WriteString(infoStyle.Render("Prizes: $500 / $250 / $100. Trash doesn't count.") + "\n\n")
+
+	content.WriteString(accentStyle.Render("RIVALS") + "\n")
+	for _, rival := range game.GetAllRivals() {
+		line := fmt.Sprintf("  %-16s", rival.Name)
+		if m.width >= 50 {
+			line += fmt.Sprintf(" speed %s  skill %s",
+				strings.Repeat("★", int(rival.CatchRate*4+0.5)), strings.Repeat("★", rival.Skill/2))
+		}
+		content.WriteString(line + "\n")
+	}
+
+	content.WriteString("\n" + accentStyle.Render(fmt.Sprintf("TROPHY CABINET (%d)", len(trophies))) + "\n")
+	if len(trophies) == 0 {
+		content.WriteString(infoStyle.Render("  No trophies yet") + "\n")
+	}
+	for _, trophy := range trophies {
+		content.WriteString(fmt.Sprintf("  🏆 %s - %s, by %s", trophy.Name, trophy.Date.Format("Jan 2 2006"), trophy.Scoring) + "\n")
+	}
+
+	content.WriteString("\n" + successStyle.Render("w: Compete by weight | v: Compete by value"))
+
+	return boxStyle.Render(content.String())
+}
+
+func (m model) renderTournamentResult() string {
+	content := strings.Builder{}
+
+	content.WriteString(historyHeaderStyle.Render("TOURNAMENT RESULTS") + "\n\n")
+
+	if m.finishedTournament == nil {
+		return boxStyle.Render(content.String())
+	}
+
+	place := m.finishedTournament.PlayerPlace()
+	landed := false
+	for _, entrant := range m.finishedTournament.Entrants {
+		if entrant.IsPlayer {
+			landed = entrant.Catches > 0
+		}
+	}
+
+	switch {
+	case !landed:
+		content.WriteString(infoStyle.Render("You didn't land a single fish. Better luck next time!") + "\n\n")
+	case place == 1:
+		content.WriteString(successStyle.Render("🥇 You won the tournament!") + "\n\n")
+	case place == 2:
+		content.WriteString(successStyle.Render("🥈 You finished second!") + "\n\n")
+	case place == 3:
+		content.WriteString(successStyle.Render("🥉 You finished third!") + "\n\n")
+	default:
+		content.WriteString(infoStyle.Render(fmt.Sprintf("You finished in place %d. Better luck next time!", place)) + "\n\n")
+	}
+
+	content.WriteString(renderLeaderboard(m.finishedTournament, m.width))
+
+	return boxStyle.Render(content.String())
+}
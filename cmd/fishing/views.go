@@ -11,6 +11,10 @@ import (
 )
 
 func (m model) View() string {
+	// Background routines update the player too, so render under the lock
+	mu.Lock()
+	defer mu.Unlock()
+
 	// Update last active time
 	lastActiveTime = time.Now()
 
@@ -26,6 +30,16 @@ func (m model) View() string {
 	// Stats are shown in all states - adjust width
 	s += renderStats(m.width) + "\n"
 
+	// Notifications such as unlocked achievements
+	if time.Now().Before(bannerUntil) {
+		s += renderBanner(bannerText, m.width) + "\n"
+	}
+
+	// Festivals have a banner of their own for as long as they're on
+	if festivals := renderFestivalBanner(m.width); festivals != "" {
+		s += festivals + "\n"
+	}
+
 	// Content depends on the current state
 	switch m.state {
 	case "menu":
@@ -42,6 +56,46 @@ func (m model) View() string {
 		s += m.renderHistory()
 	case "viewHistoryCatches":
 		s += m.renderHistoryCatches()
+	case "shop":
+		s += m.renderShop()
+	case "profile":
+		s += m.renderProfile()
+	case "achievements":
+		s += m.renderAchievements()
+	case "fishdex":
+		s += m.renderFishdex()
+	case "fishdexDetail":
+		s += m.renderFishdexDetail()
+	case "quests":
+		s += m.renderQuests()
+	case "aquarium":
+		s += m.renderAquarium()
+	case "crafting":
+		s += m.renderCrafting()
+	case "kitchen":
+		s += m.renderKitchen()
+	case "expeditions":
+		s += m.renderExpeditions()
+	case "lines":
+		s += m.renderLines()
+	case "release":
+		s += m.renderRelease()
+	case "regulations":
+		s += m.renderRegulations()
+	case "populations":
+		s += m.renderPopulations()
+	case "boss":
+		s += m.renderBoss()
+	case "festivals":
+		s += m.renderFestivals()
+	case "farm":
+		s += m.renderFarm()
+	case "expeditionReport":
+		s += m.renderExpeditionReport()
+	case "tournament":
+		s += m.renderTournament()
+	case "tournamentResult":
+		s += m.renderTournamentResult()
 	}
 
 	// Show message if present
@@ -52,15 +106,63 @@ func (m model) View() string {
 	// Simplified help text at bottom
 	var helpText string
 	if m.state == "menu" {
-		helpText = infoStyle.Render("↑↓ | Enter | a:Auto | s:Save | q:Quit")
+		helpText = infoStyle.Render("↑↓ | Enter | a:Auto | l:Lines | r:Rules | p:Stocks | f:Festivals | h:Farm | s:Save | q:Quit")
+	} else if m.state == "aquarium" && m.aquariumPicking {
+		helpText = infoStyle.Render("↑↓:Navigate | Enter:Place | q:Back")
+	} else if m.state == "aquarium" {
+		helpText = infoStyle.Render("↑↓:Navigate | p:Place fish | t:Take out | u:Upgrade | q:Back")
+	} else if m.state == "crafting" {
+		helpText = infoStyle.Render("↑↓:Navigate | Enter:Craft | u:Use | q:Back")
+	} else if m.state == "kitchen" {
+		helpText = infoStyle.Render("↑↓:Navigate | Enter:Cook | e:Eat | q:Back")
+	} else if m.state == "expeditions" {
+		helpText = infoStyle.Render("↑↓:Region | ←→:Length | Enter:Send boat | q:Back")
+	} else if m.state == "boss" && m.encounter != nil && m.encounter.IsOver() {
+		helpText = infoStyle.Render("Any key: Continue")
+	} else if m.state == "boss" {
+		helpText = infoStyle.Render("Space:Reel | h:Hold | d:Dip | u:Untangle | l:Let out | q:Cut line")
+	} else if m.state == "farm" && m.farmPicking {
+		helpText = infoStyle.Render("↑↓:Navigate | Enter:Stock | q:Back")
+	} else if m.state == "farm" {
+		helpText = infoStyle.Render("↑↓:Pond | s:Stock | h:Harvest | b:Build pond | q:Back")
+	} else if m.state == "festivals" {
+		helpText = infoStyle.Render("q:Back")
+	} else if m.state == "populations" {
+		helpText = infoStyle.Render("←→:Location | ↑↓:Page | q:Back")
+	} else if m.state == "regulations" {
+		helpText = infoStyle.Render("←→:Location | e:Enforce on/off | q:Back")
+	} else if m.state == "release" {
+		helpText = infoStyle.Render("↑↓:Navigate | Enter:Release | q:Back")
+	} else if m.state == "lines" {
+		helpText = infoStyle.Render("↑↓:Navigate | b:Bait | u:Rod holder | a:Auto | q:Back")
+	} else if m.state == "expeditionReport" {
+		helpText = infoStyle.Render("Any key: Continue")
+	} else if m.state == "tournament" {
+		helpText = infoStyle.Render("w:Weight | v:Value | q:Back")
+	} else if m.state == "tournamentResult" {
+		helpText = infoStyle.Render("Enter: Continue")
+	} else if m.state == "fishResult" && m.canRelease() {
+		helpText = infoStyle.Render("x:Release | Any key: Continue")
 	} else if m.state == "fishResult" && !autoFishing {
 		helpText = infoStyle.Render("Any key: Continue")
 	} else if m.state == "inventory" {
-		helpText = infoStyle.Render("↑↓:Navigate | h:History | a:Auto | s:Save | q:Back")
+		helpText = infoStyle.Render("↑↓:Navigate | x:Release | h:History | a:Auto | s:Save | q:Back")
 	} else if m.state == "history" {
 		helpText = infoStyle.Render("↑↓:Navigate | Enter:View | q:Back")
 	} else if m.state == "viewHistoryCatches" {
 		helpText = infoStyle.Render("↑↓:Navigate | 1-4:Sort | q:Back")
+	} else if m.state == "shop" {
+		helpText = infoStyle.Render("↑↓:Navigate | Enter:Buy | e:Equip bait | s:Save | q:Back")
+	} else if m.state == "profile" {
+		helpText = infoStyle.Render("↑↓:Navigate | Enter:Learn skill | s:Save | q:Back")
+	} else if m.state == "achievements" || m.state == "quests" {
+		helpText = infoStyle.Render("q:Back")
+	} else if m.state == "fishdex" {
+		helpText = infoStyle.Render("↑↓:Navigate | ←→:Page | Enter:Details | q:Back")
+	} else if m.state == "fishdexDetail" {
+		helpText = infoStyle.Render("↑↓:Prev/Next | q:Back")
+	} else if m.state == "fishing" {
+		helpText = infoStyle.Render("a:Auto | b:Bait | c:Chum | x:Pick chum | v:Boat | s:Save | q:Back")
 	} else if m.state != "fishResult" {
 		helpText = infoStyle.Render("a:Auto | s:Save | q:Back")
 	}
@@ -161,6 +263,38 @@ func (m model) renderFishing() string {
 		}
 	}
 
+	// Where we're fishing from
+	if boat, ok := game.GetBoatByName(player.Boat); ok {
+		if player.Money < boat.FuelCost {
+			content.WriteString(errorStyle.Render(fmt.Sprintf("No money for fuel! Fishing from the shore instead of your %s.", boat.Name)) + "\n")
+		} else {
+			content.WriteString(infoStyle.Render(fmt.Sprintf("Boat: %s (%s)", boat.Name, boat.Location)) + "\n")
+		}
+	}
+
+	// Fishing without the license the local rules ask for
+	if regulation, ok := game.GetRegulation(player.Location()); ok && regulationsOn && regulation.License != "" &&
+		!player.HasLicense(regulation.License, time.Now()) {
+		content.WriteString(errorStyle.Render(fmt.Sprintf("No %s! Anything you keep here is illegal.", regulation.License)) + "\n")
+	}
+
+	// Show what's on the hook
+	if player.BaitLeft() > 0 {
+		content.WriteString(infoStyle.Render(fmt.Sprintf("Bait: %s (%d left)", player.Bait, player.BaitLeft())) + "\n\n")
+	} else {
+		content.WriteString(errorStyle.Render("No bait! Fishing with a bare hook.") + "\n\n")
+	}
+
+	// Live standings while competing
+	if tournament != nil {
+		content.WriteString(renderTournamentStatus(m.width) + "\n")
+	}
+
+	// Catches in a row so far
+	if combo := renderCombo(player.Combo, m.fishingState+comboFrames); combo != "" {
+		content.WriteString(combo + "\n\n")
+	}
+
 	// Add fishing animation
 	if m.message != "" {
 		content.WriteString(m.message + "\n\n")
@@ -245,6 +379,11 @@ func (m model) renderFishResult() string {
 			headerColor = "#FF00FF"   // Bright magenta for legendary
 			fishNameColor = "#FFFF00" // Bright yellow for legendary names
 			catchHeader = "🏆 LEGENDARY CATCH! 🏆"
+		} else if len(m.loot) > 0 {
+			// Container full of loot
+			headerColor = "#AA7700"   // Dark gold for treasure
+			fishNameColor = "#FFAA00" // Gold for container names
+			catchHeader = "💰 TREASURE! 💰"
 		} else if fishDetails.IsTrash {
 			// Trash item header
 			headerColor = "#777777"   // Gray for trash
@@ -279,6 +418,14 @@ func (m model) renderFishResult() string {
 
 		content.WriteString(fishName + "\n\n")
 
+		// Rare variants get a word of their own
+		if variant, ok := game.VariantOf(m.caughtFish); ok {
+			content.WriteString(variantStyle(variant).
+				Width(resultWidth).
+				Align(lipgloss.Center).
+				Render(variant.Icon+" "+m.caughtFish.CatchMsg) + "\n\n")
+		}
+
 		// Fish info in compact format
 		if m.width < 50 {
 			content.WriteString(fmt.Sprintf("%dlbs|$%d\n",
@@ -287,6 +434,13 @@ func (m model) renderFishResult() string {
 			content.WriteString(fmt.Sprintf("Weight: %d lbs | Value: $%d\n",
 				m.caughtFish.Weight, m.caughtFish.Value))
 
+			// Experience earned for this catch, with the combo's share
+			xpInfo := fmt.Sprintf("+%d XP", game.CatchXP(m.caughtFish)+player.ComboXP(m.caughtFish))
+			if m.leveledUp {
+				xpInfo += fmt.Sprintf(" | LEVEL UP! You're now level %d", player.Level())
+			}
+			content.WriteString(successStyle.Render(xpInfo) + "\n")
+
 			// Add time of day preference if it exists and enough screen space
 			if fishDetails.PreferredTime != "" {
 				timeInfo := fmt.Sprintf("Most active during: %s", fishDetails.PreferredTime)
@@ -298,19 +452,45 @@ func (m model) renderFishResult() string {
 				content.WriteString(lipgloss.NewStyle().
 					Foreground(lipgloss.Color("#FF00FF")).
 					Render("A legendary creature of myth and wonder!") + "\n")
-			} else if fishDetails.IsTrash {
+			} else if fishDetails.IsTrash && len(m.loot) == 0 {
 				content.WriteString(lipgloss.NewStyle().
 					Foreground(lipgloss.Color("#777777")).
 					Render("Just some trash from the water...") + "\n")
 			}
+
+			// Warn about a catch that breaks the local rules
+			if illegal, ok := player.IllegalCatchFor(m.caughtFish); ok && m.canRelease() {
+				for _, violation := range illegal.Violations {
+					content.WriteString(errorStyle.Render("⚠ "+violation.Rule) + "\n")
+				}
+				content.WriteString(errorStyle.Render(fmt.Sprintf("Keep it and risk a $%d fine from the warden!", illegal.Fine())) + "\n")
+			}
+
+			// Warn when the species is running low here
+			if level := player.PopulationLevel(player.Location(), m.caughtFish, time.Now()); level < 0.4 {
+				content.WriteString(errorStyle.Render(fmt.Sprintf("%s stocks are %s here (%d%%)",
+					m.caughtFish.Name, strings.ToLower(game.PopulationHealth(level)), int(level*100+0.5))) + "\n")
+			}
+
+			// Small and rare fish are worth more back in the water
+			if m.canRelease() {
+				releaseInfo := fmt.Sprintf("Release it for +%d conservation", game.ReleasePoints(m.caughtFish))
+				if game.IsUndersized(m.caughtFish) {
+					releaseInfo = "Undersized! " + releaseInfo
+				}
+				content.WriteString(infoStyle.Render(releaseInfo) + "\n")
+			}
 		}
 
 		// Generate pattern based on fish properties
 		fishPattern := generateFishPattern(m.caughtFish)
 		fishingLine := fishPattern
 
-		// Show fish graphic only if there's enough space
-		if m.width >= 30 {
+		// Containers are opened up instead of hanging on the line
+		if len(m.loot) > 0 {
+			content.WriteString("\n" + renderLootReveal(m.caughtFish.Name, m.loot, m.lootFrame))
+		} else if m.width >= 30 {
+			// Show fish graphic only if there's enough space
 			// Add spacer for better positioning
 			content.WriteString("\n")
 
@@ -318,6 +498,26 @@ func (m model) renderFishResult() string {
 			fishermanWithCatch := fmt.Sprintf(fishermanWithFish, fishingLine)
 			content.WriteString(fishermanWithCatch + "\n")
 		}
+	} else if m.lineSnapped {
+		// The fish was hooked but broke the line
+		snapMsg := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#FF5555")).
+			Width(resultWidth).
+			Align(lipgloss.Center).
+			Render("SNAP! The line broke!")
+
+		content.WriteString(snapMsg + "\n\n")
+		content.WriteString(fmt.Sprintf("The %s (%d lbs) got away!\n", m.caughtFish.Name, m.caughtFish.Weight))
+		if m.width >= 50 {
+			content.WriteString(infoStyle.Render(fmt.Sprintf("Your %s is only rated for %d lbs.",
+				player.FishingRod, player.Rod().MaxWeight)) + "\n")
+		}
+
+		// Show fisherman only if there's enough space
+		if m.width >= 30 {
+			content.WriteString(fishermanFrames[0])
+		}
 	} else {
 		// Improved "no catch" message - responsive width
 		noCatchMsg := lipgloss.NewStyle().
@@ -335,6 +535,20 @@ func (m model) renderFishResult() string {
 		}
 	}
 
+	// The combo this catch added to, or the one it broke
+	if m.comboLost >= 2 {
+		content.WriteString("\n" + errorStyle.Render(fmt.Sprintf("💨 Combo x%d lost!", m.comboLost)) + "\n")
+	} else if combo := renderCombo(player.Combo, m.comboFrame); m.catchSuccess && combo != "" {
+		content.WriteString("\n" + combo + "\n")
+	}
+
+	// Warn when the rod is close to breaking
+	rod := player.Rod()
+	if player.RodDurability() <= rod.MaxDurability/5 {
+		content.WriteString("\n" + errorStyle.Render(fmt.Sprintf("Rod worn: %d/%d durability",
+			player.RodDurability(), rod.MaxDurability)))
+	}
+
 	// Only show auto-continuing message if auto-fishing is enabled
 	if autoFishing {
 		content.WriteString("\n" +
@@ -710,6 +924,21 @@ func (m model) renderInventory() string {
 	return boxStyle.Render(content.String())
 }
 
+// renderBanner draws a notification strip across the screen
+func renderBanner(text string, width int) string {
+	bannerWidth := 60
+	if width < 70 {
+		bannerWidth = width - 4
+	}
+	return lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#000000")).
+		Background(lipgloss.Color("#FFCC00")).
+		Padding(0, 1).
+		Width(bannerWidth).
+		Render(text)
+}
+
 // A more compact game title
 func compactGameTitle() string {
 	return `🎣 Fishing Game 🎣`
@@ -736,6 +965,32 @@ func renderStats(width int) string {
 	// Ultra-compact stats
 	statsBuilder := strings.Builder{}
 
+	// Countdowns for chum in the water and dishes that are still working
+	effectsInfo := ""
+	if chum, ok := currentChum(); ok {
+		remaining := time.Until(chumExpires)
+		effectsInfo = fmt.Sprintf(" | %s %d:%02d", chum.Name,
+			int(remaining.Minutes()), int(remaining.Seconds())%60)
+	}
+	if expedition := player.Expedition; expedition != nil {
+		remaining := time.Until(expedition.ReturnsAt())
+		if remaining < 0 {
+			remaining = 0
+		}
+		effectsInfo += fmt.Sprintf(" | ⛵ %s %d:%02d:%02d", expedition.Region,
+			int(remaining.Hours()), int(remaining.Minutes())%60, int(remaining.Seconds())%60)
+	}
+	for _, occurrence := range currentEvents() {
+		remaining := time.Until(occurrence.Ends)
+		effectsInfo += fmt.Sprintf(" | %s %s %d:%02d", occurrence.Event().Icon, occurrence.Name,
+			int(remaining.Hours()), int(remaining.Minutes())%60)
+	}
+	for _, buff := range currentBuffs() {
+		remaining := time.Until(buff.Expires)
+		effectsInfo += fmt.Sprintf(" | 🍳 %s %d:%02d", buff.Dish.Name,
+			int(remaining.Minutes()), int(remaining.Seconds())%60)
+	}
+
 	// Show fish count and auto status
 	if width < 40 {
 		// Super compact view - just the essentials
@@ -743,18 +998,21 @@ func renderStats(width int) string {
 			len(player.FishCaught), currentPeriod.Icon))
 	} else if width < 60 {
 		// Compact view
-		statsBuilder.WriteString(fmt.Sprintf("Fish: %d | Auto: %s | %s %s",
+		statsBuilder.WriteString(fmt.Sprintf("Fish: %d | $%d | Auto: %s | %s %s",
 			len(player.FishCaught),
+			player.Money,
 			autoStatusStyle.Render(autoStatus),
 			currentPeriod.Icon,
 			timeOfDay))
+		statsBuilder.WriteString(successStyle.Render(effectsInfo))
 	} else {
 		// Full view with all details
 		timeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#88CCFF"))
 
 		// Show more details in widescreen
-		statsBuilder.WriteString(fmt.Sprintf("Fish: %d | Auto: %s | %s %s",
+		statsBuilder.WriteString(fmt.Sprintf("Fish: %d | $%d | Auto: %s | %s %s",
 			len(player.FishCaught),
+			player.Money,
 			autoStatusStyle.Render(autoStatus),
 			currentPeriod.Icon,
 			timeStyle.Render(timeOfDay+" - "+currentPeriod.Description)))
@@ -767,6 +1025,20 @@ func renderStats(width int) string {
 		// Show catch factor if there's space
 		catchInfo := fmt.Sprintf(" | Catch Rate: %.1fx", timeFactor)
 		statsBuilder.WriteString(infoStyle.Render(catchInfo))
+
+		// Where we're fishing from
+		statsBuilder.WriteString(infoStyle.Render(" | " + player.Location()))
+
+		// Show rod condition, highlighted once it's nearly worn out
+		rod := player.Rod()
+		rodInfo := fmt.Sprintf(" | Rod: %d/%d", player.RodDurability(), rod.MaxDurability)
+		if player.RodDurability() <= rod.MaxDurability/5 {
+			statsBuilder.WriteString(errorStyle.Render(rodInfo))
+		} else {
+			statsBuilder.WriteString(infoStyle.Render(rodInfo))
+		}
+
+		statsBuilder.WriteString(successStyle.Render(effectsInfo))
 	}
 
 	return boxStyle.Render(statsBuilder.String())
@@ -802,13 +1074,20 @@ func (m model) renderHistory() string {
 
 			// Get fish count for this date
 			count, weight, value := getFishCaughtDetails(date)
-			dateInfo := fmt.Sprintf("%s (%d fish, %dlbs, $%d)", dateStr, count, weight, value)
+			summary := fmt.Sprintf("%d fish, %dlbs, $%d", count, weight, value)
+			if released := len(getFishReleasedOnDate(date)); released > 0 {
+				summary += fmt.Sprintf(", %d released", released)
+			}
+			for _, occurrence := range getEventsOnDate(date) {
+				summary += " " + occurrence.Event().Icon
+			}
+			dateInfo := fmt.Sprintf("%s (%s)", dateStr, summary)
 
 			// Highlight selected date
 			if i == m.historyDateIndex {
 				content.WriteString(highlightedDateStyle.Render(dateInfo))
 			} else {
-				content.WriteString(dateStyle.Render(dateStr) + fmt.Sprintf(" - %d fish, %dlbs, $%d", count, weight, value))
+				content.WriteString(dateStyle.Render(dateStr) + " - " + summary)
 			}
 		}
 		content.WriteString("\n")
@@ -847,6 +1126,15 @@ func (m model) renderHistoryCatches() string {
 		content.WriteString(historyHeaderStyle.Render("PAST CATCHES - "+dateHeader) + "\n\n")
 	}
 
+	// What was going on in the world that day
+	for _, occurrence := range getEventsOnDate(viewingDate) {
+		content.WriteString(accentStyle.Render(fmt.Sprintf("%s %s", occurrence.Event().Icon, occurrence.Name)) +
+			" " + infoStyle.Render(occurrence.Description) + "\n")
+	}
+	if len(getEventsOnDate(viewingDate)) > 0 {
+		content.WriteString("\n")
+	}
+
 	// Get fish caught on this date
 	fishCaught := getFishCaughtOnDate(viewingDate)
 
@@ -1178,6 +1466,23 @@ func (m model) renderHistoryCatches() string {
 			dateObj.Format("01/02"), totalFish, totalValue)))
 	}
 
+	// Fish that were let go again that day
+	if released := getFishReleasedOnDate(viewingDate); len(released) > 0 {
+		points := 0
+		for _, release := range released {
+			points += release.Points
+		}
+		content.WriteString("\n\n" + accentStyle.Render(fmt.Sprintf("RELEASED (%d, +%d conservation)", len(released), points)) + "\n")
+		for _, release := range released {
+			if m.width >= 50 {
+				content.WriteString(fmt.Sprintf("  %s %s (%d lbs) +%d\n",
+					release.Time.Format("15:04"), release.Fish.Name, release.Fish.Weight, release.Points))
+			} else {
+				content.WriteString(fmt.Sprintf("  %s +%d\n", release.Fish.Name, release.Points))
+			}
+		}
+	}
+
 	// Add sorting help
 	content.WriteString("\n\n")
 	if m.width >= 60 {
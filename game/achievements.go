@@ -0,0 +1,116 @@
+package game
+
+import "time"
+
+// Achievement is a milestone unlocked by reaching a goal
+type Achievement struct {
+	ID          string
+	Name        string
+	Description string
+	Kind        string // What is measured, see Progress
+	Param       string // Extra parameter for the kind, e.g. a time of day
+	Goal        int    // Amount needed to unlock, 0 for "the whole collection"
+}
+
+// GetAllAchievements returns every achievement in the game
+func GetAllAchievements() []Achievement {
+	return []Achievement{
+		{"first_catch", "First Bite", "Land your first fish", "total_caught", "", 1},
+		{"century", "Century", "Land 100 fish", "total_caught", "", 100},
+		{"busy_day", "Busy Day", "Land 100 fish in a single day", "caught_today", "", 100},
+		{"first_legendary", "Myth Hunter", "Catch a legendary creature", "legendary_caught", "", 1},
+		{"collector", "Collector", "Catch 25 different species", "species_caught", "", 25},
+		{"early_bird", "Early Bird", "Catch every Morning species", "time_collection", "Morning", 0},
+		{"night_owl", "Night Owl", "Catch every Night species", "time_collection", "Night", 0},
+		{"beachcomber", "Beachcomber", "Fish up every kind of trash", "trash_collection", "", 0},
+		{"fishmonger", "Fishmonger", "Earn $1000 selling fish", "money_sold", "", 1000},
+	}
+}
+
+// collection returns the catalog entries a collection achievement is about
+func (a Achievement) collection() []Fish {
+	switch a.Kind {
+	case "time_collection":
+		timeFish := []Fish{}
+		for _, fish := range GetAllFish() {
+			if fish.PreferredTime == a.Param && !fish.IsTrash {
+				timeFish = append(timeFish, fish)
+			}
+		}
+		return timeFish
+	case "trash_collection":
+		return GetTrashItems()
+	}
+	return nil
+}
+
+// Target returns the amount of progress needed to unlock the achievement
+func (a Achievement) Target() int {
+	if a.Goal == 0 {
+		return len(a.collection())
+	}
+	return a.Goal
+}
+
+// Progress returns how far the player is towards the achievement, capped at the target
+func (a Achievement) Progress(p *Player, now time.Time) int {
+	stats := p.Stats
+	progress := 0
+
+	switch a.Kind {
+	case "total_caught":
+		progress = stats.TotalCaught
+	case "caught_today":
+		if stats.Day == now.Format("2006-01-02") {
+			progress = stats.CaughtToday
+		}
+	case "legendary_caught":
+		progress = stats.LegendaryCaught
+	case "species_caught":
+		for name, record := range stats.Species {
+			if record.TimesCaught > 0 && !isTrashName(name) {
+				progress++
+			}
+		}
+	case "time_collection", "trash_collection":
+		progress = p.CountDiscovered(a.collection())
+	case "money_sold":
+		progress = stats.TotalSold
+	}
+
+	if progress > a.Target() {
+		progress = a.Target()
+	}
+	return progress
+}
+
+// isTrashName reports whether a catalog entry with this name is trash
+func isTrashName(name string) bool {
+	for _, item := range GetTrashItems() {
+		if item.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckAchievements unlocks any achievements whose goal has been reached and
+// returns the newly unlocked ones
+func (p *Player) CheckAchievements(now time.Time) []Achievement {
+	unlocked := []Achievement{}
+	for _, achievement := range GetAllAchievements() {
+		if _, done := p.Achievements[achievement.ID]; done {
+			continue
+		}
+		if achievement.Progress(p, now) < achievement.Target() {
+			continue
+		}
+
+		if p.Achievements == nil {
+			p.Achievements = make(map[string]time.Time)
+		}
+		p.Achievements[achievement.ID] = now
+		unlocked = append(unlocked, achievement)
+	}
+	return unlocked
+}
@@ -0,0 +1,83 @@
+package game
+
+// TankTier is a size of aquarium tank
+type TankTier struct {
+	Name     string
+	Cost     int
+	Capacity int // Number of fish the tank holds
+}
+
+// GetAllTanks returns every aquarium tank, smallest first
+func GetAllTanks() []TankTier {
+	return []TankTier{
+		{"Fishbowl", 0, 3},
+		{"Home Tank", 300, 6},
+		{"Reef Tank", 1200, 12},
+		{"Public Aquarium", 5000, 24},
+	}
+}
+
+// Aquarium holds live fish kept instead of sold
+type Aquarium struct {
+	Tier int // Index of the tank in GetAllTanks
+	Fish []Fish
+}
+
+// Tank returns the catalog entry for the aquarium's current tank
+func (a *Aquarium) Tank() TankTier {
+	tanks := GetAllTanks()
+	if a.Tier < 0 || a.Tier >= len(tanks) {
+		return tanks[0]
+	}
+	return tanks[a.Tier]
+}
+
+// NextTank returns the next tank upgrade, if there is one
+func (a *Aquarium) NextTank() (TankTier, bool) {
+	tanks := GetAllTanks()
+	if a.Tier+1 >= len(tanks) {
+		return TankTier{}, false
+	}
+	return tanks[a.Tier+1], true
+}
+
+// IsFull reports whether there's no room left in the tank
+func (a *Aquarium) IsFull() bool {
+	return len(a.Fish) >= a.Tank().Capacity
+}
+
+// PlaceInAquarium moves a fish from the inventory into the tank. Trash can't
+// go in, and neither can anything once the tank is full.
+func (p *Player) PlaceInAquarium(index int) bool {
+	if index < 0 || index >= len(p.FishCaught) || p.FishCaught[index].IsTrash || p.Aquarium.IsFull() {
+		return false
+	}
+
+	fish, _ := p.RemoveFish(index)
+	p.Aquarium.Fish = append(p.Aquarium.Fish, fish)
+	return true
+}
+
+// TakeFromAquarium moves a fish from the tank back into the inventory
+func (p *Player) TakeFromAquarium(index int) bool {
+	if index < 0 || index >= len(p.Aquarium.Fish) {
+		return false
+	}
+
+	fish := p.Aquarium.Fish[index]
+	p.Aquarium.Fish = append(p.Aquarium.Fish[:index], p.Aquarium.Fish[index+1:]...)
+	p.AddFish(fish)
+	return true
+}
+
+// UpgradeTank buys the next size of tank
+func (p *Player) UpgradeTank() bool {
+	next, ok := p.Aquarium.NextTank()
+	if !ok || p.Money < next.Cost {
+		return false
+	}
+
+	p.Money -= next.Cost
+	p.Aquarium.Tier++
+	return true
+}
@@ -0,0 +1,68 @@
+package game
+
+import "strings"
+
+// BaitType represents a kind of bait and the fish it attracts
+type BaitType struct {
+	Name          string
+	Cost          int      // Price of one pack
+	PackSize      int      // Number of casts in one pack
+	Strength      int      // Bonus added to the catch chance
+	Description   string   // Short description shown in the shop
+	Habitats      []string // Habitats whose fish go for this bait, nil for any
+	MinWeight     int      // Smallest fish (lbs) it appeals to, 0 for no minimum
+	MaxWeight     int      // Largest fish (lbs) it appeals to, 0 for no maximum
+	PreferredTime string   // Only attracts fish active at this time, "" for any
+	Legendary     bool     // Whether it also draws out legendary creatures
+}
+
+// GetAllBait returns every kind of bait available in the game
+func GetAllBait() []BaitType {
+	return []BaitType{
+		{"Worm", 10, 10, 1, "Panfish can't resist a wriggling worm", []string{"Freshwater", "Pond", "Lake", "Stream"}, 0, 5, "", false},
+		{"Live Minnow", 25, 10, 2, "Tempts hungry freshwater predators", []string{"Lake", "River", "Freshwater"}, 5, 50, "", false},
+		{"Shrimp", 30, 10, 2, "A favorite of coastal and reef fish", []string{"Coastal", "Reef", "Flats", "Ocean"}, 0, 40, "", false},
+		{"Squid", 60, 10, 3, "Big ocean predators love squid", []string{"Ocean", "Deep Sea"}, 20, 0, "", false},
+		{"Glowing Lure", 200, 5, 2, "Shines in the dark, said to lure creatures of legend", nil, 0, 0, "Night", true},
+	}
+}
+
+// GetBaitByName returns the bait with the given name
+func GetBaitByName(name string) (BaitType, bool) {
+	for _, bait := range GetAllBait() {
+		if bait.Name == name {
+			return bait, true
+		}
+	}
+	return BaitType{}, false
+}
+
+// Attracts reports whether this bait appeals to the given fish.
+// A bare hook (the zero BaitType) attracts nothing.
+func (b BaitType) Attracts(fish Fish) bool {
+	if b.Name == "" || fish.IsTrash {
+		return false
+	}
+	if fish.IsLegendary && !b.Legendary {
+		return false
+	}
+	if b.PreferredTime != "" && fish.PreferredTime != b.PreferredTime {
+		return false
+	}
+	if b.MinWeight > 0 && fish.Weight < b.MinWeight {
+		return false
+	}
+	if b.MaxWeight > 0 && fish.Weight > b.MaxWeight {
+		return false
+	}
+
+	if len(b.Habitats) == 0 {
+		return true
+	}
+	for _, habitat := range b.Habitats {
+		if strings.Contains(fish.Habitat, habitat) {
+			return true
+		}
+	}
+	return false
+}
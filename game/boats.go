@@ -0,0 +1,120 @@
+package game
+
+// Boat takes the player out to deeper waters
+type Boat struct {
+	Name        string
+	Cost        int
+	Tier        int    // Deepest habitat tier the boat can reach
+	FuelCost    int    // Money spent on fuel for each cast from the boat
+	Location    string // Where the boat takes the player
+	Description string
+}
+
+// Location of the player when fishing without a boat
+const ShoreLocation = "Shore"
+
+// GetAllBoats returns every boat, smallest first
+func GetAllBoats() []Boat {
+	return []Boat{
+		{"Rowboat", 500, 1, 0, "Offshore", "Oars only - reaches the open ocean"},
+		{"Trawler", 3000, 2, 3, "Deep Sea", "A diesel workhorse for the deep ocean"},
+		{"Research Vessel", 12000, 3, 10, "Abyssal Trench", "Sonar and a winch line for the abyss and the hadal zone"},
+	}
+}
+
+// GetBoatByName returns the boat with the given name
+func GetBoatByName(name string) (Boat, bool) {
+	for _, boat := range GetAllBoats() {
+		if boat.Name == name {
+			return boat, true
+		}
+	}
+	return Boat{}, false
+}
+
+// Boat tier needed to reach each deep-water habitat. Every other habitat can
+// be fished from the shore.
+var habitatTiers = map[string]int{
+	"Open Ocean": 1,
+	"Deep Ocean": 2,
+	"Abyss":      3,
+	"Hadal Zone": 3,
+}
+
+// RequiredTier returns the boat tier needed to catch a fish
+func RequiredTier(fish Fish) int {
+	return habitatTiers[fish.Habitat]
+}
+
+// RequiredBoat returns the smallest boat that can reach a fish, if it needs one
+func RequiredBoat(fish Fish) (Boat, bool) {
+	tier := RequiredTier(fish)
+	if tier == 0 {
+		return Boat{}, false
+	}
+	return BoatForTier(tier)
+}
+
+// BoatForTier returns the smallest boat that reaches the given tier
+func BoatForTier(tier int) (Boat, bool) {
+	for _, boat := range GetAllBoats() {
+		if boat.Tier >= tier {
+			return boat, true
+		}
+	}
+	return Boat{}, false
+}
+
+// OwnsBoat reports whether the player has bought the given boat
+func (p *Player) OwnsBoat(name string) bool {
+	for _, owned := range p.Boats {
+		if owned == name {
+			return true
+		}
+	}
+	return false
+}
+
+// BuyBoat buys a boat and takes it out straight away
+func (p *Player) BuyBoat(name string) bool {
+	boat, ok := GetBoatByName(name)
+	if !ok || p.OwnsBoat(name) || p.Money < boat.Cost {
+		return false
+	}
+
+	p.Money -= boat.Cost
+	p.Boats = append(p.Boats, boat.Name)
+	p.Boat = boat.Name
+	return true
+}
+
+// UseBoat picks which owned boat to fish from, or "" to fish from the shore.
+// A boat away on an expedition can't be used.
+func (p *Player) UseBoat(name string) bool {
+	if name != "" && (!p.OwnsBoat(name) || p.BoatAway(name)) {
+		return false
+	}
+	p.Boat = name
+	return true
+}
+
+// Location returns where the player is fishing from
+func (p *Player) Location() string {
+	if boat, ok := GetBoatByName(p.Boat); ok {
+		return boat.Location
+	}
+	return ShoreLocation
+}
+
+// LaunchBoat pays for the fuel of one cast from the player's boat and returns
+// the habitat tier the cast can reach. Without a boat, or without the money
+// for fuel, the cast is made from the shore.
+func (p *Player) LaunchBoat() int {
+	boat, ok := GetBoatByName(p.Boat)
+	if !ok || p.Money < boat.FuelCost {
+		return 0
+	}
+
+	p.Money -= boat.FuelCost
+	return boat.Tier
+}
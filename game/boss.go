@@ -0,0 +1,280 @@
+package game
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// BossMove is a special move a legendary creature makes during an encounter.
+// The player has to press the counter key before the window closes.
+type BossMove struct {
+	Name    string
+	Key     string // Key that counters the move
+	Action  string // What the player does to counter it
+	Warning string // Shown when the move starts, %s is the creature's name
+	Damage  int    // Line strength lost when the move isn't countered
+}
+
+// Boss is how a legendary creature fights once it's hooked
+type Boss struct {
+	Name    string
+	Art     string
+	Stamina int
+	Moves   []string
+}
+
+// Number of phases in an encounter. The creature fights harder in each one.
+const BossPhases = 3
+
+// Strength of the line at the start of an encounter
+const FullLine = 100
+
+// GetAllBossMoves returns every special move
+func GetAllBossMoves() []BossMove {
+	return []BossMove{
+		{"Ink Cloud", "h", "Hold steady", "The %s sprays a cloud of ink! You can't see the line!", 15},
+		{"Breach", "d", "Dip the rod", "The %s breaches out of the water!", 25},
+		{"Line Tangle", "u", "Untangle", "The %s wraps your line around a rock!", 20},
+		{"Deep Dive", "l", "Let out line", "The %s dives for the depths!", 20},
+	}
+}
+
+// GetBossMoveByName returns the move with the given name
+func GetBossMoveByName(name string) (BossMove, bool) {
+	for _, move := range GetAllBossMoves() {
+		if move.Name == name {
+			return move, true
+		}
+	}
+	return BossMove{}, false
+}
+
+// GetAllBosses returns the creatures with encounters of their own
+func GetAllBosses() []Boss {
+	return []Boss{
+		{"Kraken", `
+          ___
+       .-'   '-.
+      /  O   O  \
+     |     ^     |
+      \  \___/  /
+    __/'-.___.-'\__
+   / /  /  | |  \  \ \
+  ( (  (   | |   )  ) )
+   \ \  \  | |  /  / /`, 140, []string{"Ink Cloud", "Line Tangle", "Deep Dive"}},
+		{"Megalodon", `
+                  __
+                 / /
+   _____________/ /______
+  /  o                   '-._
+ <   VVVVVVVVVV            __>
+  \__^^^^^^^^^^_______.-'
+                \ \
+                 \_\`, 160, []string{"Breach", "Deep Dive", "Line Tangle"}},
+		{"Loch Ness Monster", `
+                __
+               / _)
+      _.----._/ /
+     /         /
+  __/ (  | (  |
+ /__.-'|_|--|_|`, 120, []string{"Breach", "Line Tangle", "Deep Dive"}},
+	}
+}
+
+// GetBoss returns how a legendary creature fights. Creatures without an
+// encounter of their own loom as a shadow and use every move.
+func GetBoss(name string) Boss {
+	for _, boss := range GetAllBosses() {
+		if boss.Name == name {
+			return boss
+		}
+	}
+
+	moves := []string{}
+	for _, move := range GetAllBossMoves() {
+		moves = append(moves, move.Name)
+	}
+	return Boss{name, `
+        .-~~~~~~~~~-.
+      .'  ?       ?  '.
+     (   ~~~~~~~~~~~   )
+      '-.__       __.-'
+           '~~~~~'`, 100, moves}
+}
+
+// Encounter is the fight with a hooked legendary creature
+type Encounter struct {
+	Boss     Boss
+	Fish     Fish
+	Stamina  int       // Stamina the creature has left
+	Line     int       // Strength the line has left
+	Phase    int       // Current phase, from 0
+	Move     *BossMove // Move being made, nil between moves
+	MoveEnds time.Time // When the player runs out of time to counter it
+	NextMove time.Time // When the creature makes its next move
+	Log      string    // What just happened
+}
+
+// NewEncounter starts the fight with a hooked creature
+func NewEncounter(fish Fish, now time.Time) *Encounter {
+	boss := GetBoss(fish.Name)
+	e := &Encounter{
+		Boss:    boss,
+		Fish:    fish,
+		Stamina: boss.Stamina,
+		Line:    FullLine,
+		Log:     fmt.Sprintf("You've hooked the %s! Reel it in!", fish.Name),
+	}
+	e.scheduleMove(now)
+	return e
+}
+
+// moveWindow returns how long the player has to counter a move in the current phase
+func (e *Encounter) moveWindow() time.Duration {
+	return time.Duration(2000-400*e.Phase) * time.Millisecond
+}
+
+// scheduleMove picks when the creature makes its next move, sooner in later phases
+func (e *Encounter) scheduleMove(now time.Time) {
+	pause := time.Duration(3000-700*e.Phase+rand.Intn(1500)) * time.Millisecond
+	e.NextMove = now.Add(pause)
+}
+
+// updatePhase moves to the next phase as the creature tires
+func (e *Encounter) updatePhase() {
+	phase := (e.Boss.Stamina - e.Stamina) * BossPhases / e.Boss.Stamina
+	if phase >= BossPhases {
+		phase = BossPhases - 1
+	}
+	if phase > e.Phase {
+		e.Phase = phase
+		e.Log = fmt.Sprintf("The %s is enraged! It fights even harder!", e.Fish.Name)
+	}
+}
+
+// Reel pulls the creature in when it isn't making a move. Reeling during a
+// move puts the line under strain instead.
+func (e *Encounter) Reel(power int, now time.Time) {
+	if e.IsOver() {
+		return
+	}
+	if e.Move != nil {
+		e.failMove(now)
+		return
+	}
+
+	e.Stamina -= power
+	if e.Stamina < 0 {
+		e.Stamina = 0
+	}
+	e.Log = fmt.Sprintf("You reel in hard. The %s is tiring!", e.Fish.Name)
+	e.updatePhase()
+}
+
+// Counter answers the move being made with a key press. Returns false if
+// the key isn't a counter at all.
+func (e *Encounter) Counter(key string, now time.Time) bool {
+	isCounter := false
+	for _, move := range GetAllBossMoves() {
+		if move.Key == key {
+			isCounter = true
+		}
+	}
+	if !isCounter || e.IsOver() {
+		return false
+	}
+
+	if e.Move == nil {
+		e.Log = "Nothing to counter yet. Keep reeling!"
+		return true
+	}
+	if e.Move.Key != key {
+		e.failMove(now)
+		return true
+	}
+
+	// A good counter tires the creature out too
+	e.Log = fmt.Sprintf("You %s and ride out the %s!", lowerFirst(e.Move.Action), e.Move.Name)
+	e.Stamina -= 5
+	if e.Stamina < 0 {
+		e.Stamina = 0
+	}
+	e.Move = nil
+	e.updatePhase()
+	e.scheduleMove(now)
+	return true
+}
+
+// failMove puts the strain of an uncountered move on the line
+func (e *Encounter) failMove(now time.Time) {
+	e.Line -= e.Move.Damage
+	if e.Line < 0 {
+		e.Line = 0
+	}
+	e.Log = fmt.Sprintf("The %s strains your line! (-%d)", e.Move.Name, e.Move.Damage)
+	e.Move = nil
+	e.scheduleMove(now)
+}
+
+// Advance starts the creature's next move and fails any move the player
+// didn't counter in time
+func (e *Encounter) Advance(now time.Time) {
+	if e.IsOver() {
+		return
+	}
+
+	if e.Move != nil {
+		if !now.Before(e.MoveEnds) {
+			e.failMove(now)
+		}
+		return
+	}
+
+	if !now.Before(e.NextMove) {
+		move, _ := GetBossMoveByName(e.Boss.Moves[rand.Intn(len(e.Boss.Moves))])
+		e.Move = &move
+		e.MoveEnds = now.Add(e.moveWindow())
+		e.Log = fmt.Sprintf(move.Warning, e.Fish.Name)
+	}
+}
+
+// Won reports whether the creature has been worn out and landed
+func (e *Encounter) Won() bool {
+	return e.Stamina <= 0
+}
+
+// Lost reports whether the line gave out and the creature escaped
+func (e *Encounter) Lost() bool {
+	return e.Line <= 0
+}
+
+// IsOver reports whether the encounter has ended either way
+func (e *Encounter) IsOver() bool {
+	return e.Won() || e.Lost()
+}
+
+// lowerFirst lowercases the first letter of a phrase
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[size:]
+}
+
+// BossAvailable reports whether a legendary creature has come back since it
+// last escaped
+func (p *Player) BossAvailable(name string, now time.Time) bool {
+	return !now.Before(p.BossCooldowns[name])
+}
+
+// BossEscaped keeps an escaped creature away until the given time
+func (p *Player) BossEscaped(name string, until time.Time) {
+	if p.BossCooldowns == nil {
+		p.BossCooldowns = map[string]time.Time{}
+	}
+	p.BossCooldowns[name] = until
+}
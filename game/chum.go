@@ -0,0 +1,49 @@
+package game
+
+import (
+	"strings"
+	"time"
+)
+
+// Chum is a consumable thrown into the water to draw fish to the current spot
+type Chum struct {
+	Name        string
+	Cost        int
+	Duration    time.Duration // How long the effect lasts once thrown
+	BiteBoost   float64       // Multiplier on how quickly and how often fish bite
+	Habitats    []string      // Habitats whose fish are drawn in
+	WeightBonus int           // Added to the weight of matching species
+	Description string        // Short description shown in the shop
+}
+
+// GetAllChum returns every kind of chum available in the game
+func GetAllChum() []Chum {
+	return []Chum{
+		{"Ground Bait", 40, 5 * time.Minute, 1.3, []string{"Freshwater", "Pond", "Lake", "Stream", "River"}, 3, "Breadcrumb mix that draws in freshwater fish"},
+		{"Chum Bucket", 80, 5 * time.Minute, 1.5, []string{"Ocean", "Coastal", "Reef", "Flats"}, 4, "Smelly fish scraps that bring saltwater fish running"},
+		{"Blood Slick", 250, 3 * time.Minute, 1.8, []string{"Deep", "Open Ocean"}, 6, "Draws big predators up from the deep"},
+	}
+}
+
+// GetChumByName returns the chum with the given name
+func GetChumByName(name string) (Chum, bool) {
+	for _, chum := range GetAllChum() {
+		if chum.Name == name {
+			return chum, true
+		}
+	}
+	return Chum{}, false
+}
+
+// Draws reports whether this chum attracts the given fish
+func (c Chum) Draws(fish Fish) bool {
+	if fish.IsTrash || fish.IsLegendary {
+		return false
+	}
+	for _, habitat := range c.Habitats {
+		if strings.Contains(fish.Habitat, habitat) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package game
+
+import "math"
+
+// Combo is a run of successful casts in a row. Catching the same schooling
+// species again and again adds to it even more.
+type Combo struct {
+	Count   int    // Successful casts in a row
+	Species string // Species of the last catch
+	School  int    // Catches of that species in a row, if it swims in schools
+}
+
+// Most a combo can multiply value and XP by
+const maxComboMultiplier = 3.0
+
+// Species that swim in schools, so where there's one there are more
+var schoolingSpecies = map[string]bool{
+	"Minnow":        true,
+	"Perch":         true,
+	"Bluegill":      true,
+	"Crappie":       true,
+	"Sunfish":       true,
+	"Bream":         true,
+	"Yellowtail":    true,
+	"Snapper":       true,
+	"Mahi-Mahi":     true,
+	"Amberjack":     true,
+	"Tuna":          true,
+	"Bluefin Tuna":  true,
+	"King Mackerel": true,
+	"Barracuda":     true,
+	"Bonefish":      true,
+}
+
+// IsSchooling reports whether a species swims in schools
+func IsSchooling(fish Fish) bool {
+	return schoolingSpecies[fish.Name]
+}
+
+// Multiplier returns how much the combo adds to a catch's value and XP.
+// Every cast in a row adds 10%, and every schooling fish of the same
+// species in a row adds another 15%.
+func (c Combo) Multiplier() float64 {
+	multiplier := 1.0
+	if c.Count > 1 {
+		multiplier += 0.1 * float64(c.Count-1)
+	}
+	if c.School > 1 {
+		multiplier += 0.15 * float64(c.School-1)
+	}
+	return math.Min(multiplier, maxComboMultiplier)
+}
+
+// Add returns the combo after landing a catch. Trash breaks it.
+func (c Combo) Add(fish Fish) Combo {
+	if fish.IsTrash {
+		return Combo{}
+	}
+
+	next := Combo{Count: c.Count + 1, Species: fish.Name}
+	if IsSchooling(fish) {
+		next.School = 1
+		if fish.Name == c.Species {
+			next.School = c.School + 1
+		}
+	}
+	return next
+}
+
+// WithCombo returns a catch with the value the combo gives it once it's landed
+func (p *Player) WithCombo(fish Fish) Fish {
+	fish.Value = SalePrice(fish.Value, p.Combo.Add(fish).Multiplier())
+	return fish
+}
+
+// ExtendCombo adds a landed catch to the combo, keeping the record of the
+// longest one
+func (p *Player) ExtendCombo(fish Fish) {
+	p.Combo = p.Combo.Add(fish)
+	if p.Combo.Count > p.BestCombo {
+		p.BestCombo = p.Combo.Count
+	}
+}
+
+// BreakCombo ends the combo after a miss and returns how long it was
+func (p *Player) BreakCombo() int {
+	count := p.Combo.Count
+	p.Combo = Combo{}
+	return count
+}
+
+// ComboXP returns the extra experience the combo adds to a catch
+func (p *Player) ComboXP(fish Fish) int {
+	return int(float64(CatchXP(fish)) * (p.Combo.Multiplier() - 1))
+}
@@ -0,0 +1,122 @@
+package game
+
+import "time"
+
+// Release is a fish that was let go instead of kept
+type Release struct {
+	Fish   Fish
+	Points int // Conservation points earned for letting it go
+	Time   time.Time
+}
+
+// ConservationReward is paid out once when the conservation score reaches it
+type ConservationReward struct {
+	Score     int
+	Title     string
+	Money     int
+	Bait      string // Bait handed out with the reward, "" for none
+	BaitCount int
+}
+
+// GetConservationRewards returns every conservation reward, lowest score first
+func GetConservationRewards() []ConservationReward {
+	return []ConservationReward{
+		{10, "Catch & Release Rookie", 0, "Shrimp", 20},
+		{50, "River Keeper", 500, "", 0},
+		{150, "Reef Guardian", 1500, "Squid", 20},
+		{400, "Ocean Steward", 5000, "Glowing Lure", 5},
+	}
+}
+
+// IsUndersized reports whether a fish came in well below the usual size of
+// its species
+func IsUndersized(fish Fish) bool {
+	for _, species := range GetAllFish() {
+		if species.Name == fish.Name {
+			return fish.Weight*10 < species.Weight*9
+		}
+	}
+	return false
+}
+
+// ReleasePoints returns the conservation points for letting a fish go.
+// Undersized and rare fish are worth the most. Trash can't be released.
+func ReleasePoints(fish Fish) int {
+	if fish.IsTrash {
+		return 0
+	}
+
+	points := 1
+	if IsUndersized(fish) {
+		points += 2
+	}
+	if fish.IsLegendary {
+		points += 25
+	} else if fish.Rarity <= 4 {
+		points += 5
+	}
+	return points
+}
+
+// ReleaseFish lets the fish at the given inventory position go. It returns
+// the release and any rewards the new conservation score unlocked.
+func (p *Player) ReleaseFish(index int, now time.Time) (Release, []ConservationReward, bool) {
+	if index < 0 || index >= len(p.FishCaught) || p.FishCaught[index].IsTrash {
+		return Release{}, nil, false
+	}
+
+	fish, _ := p.RemoveFish(index)
+	release := Release{Fish: fish, Points: ReleasePoints(fish), Time: now}
+
+	before := p.Conservation
+	p.Conservation += release.Points
+	p.Released++
+
+	// Pay out every reward passed on the way up
+	unlocked := []ConservationReward{}
+	for _, reward := range GetConservationRewards() {
+		if before < reward.Score && p.Conservation >= reward.Score {
+			p.Money += reward.Money
+			if reward.Bait != "" {
+				if p.BaitStock == nil {
+					p.BaitStock = map[string]int{}
+				}
+				p.BaitStock[reward.Bait] += reward.BaitCount
+			}
+			unlocked = append(unlocked, reward)
+		}
+	}
+	return release, unlocked, true
+}
+
+// ReleaseLatest lets go of the most recent catch matching the given fish, as
+// when it's released straight from the line
+func (p *Player) ReleaseLatest(fish Fish, now time.Time) (Release, []ConservationReward, bool) {
+	for i := len(p.FishCaught) - 1; i >= 0; i-- {
+		if p.FishCaught[i] == fish {
+			return p.ReleaseFish(i, now)
+		}
+	}
+	return Release{}, nil, false
+}
+
+// ConservationTitle returns the title of the highest reward reached, if any
+func (p *Player) ConservationTitle() string {
+	title := ""
+	for _, reward := range GetConservationRewards() {
+		if p.Conservation >= reward.Score {
+			title = reward.Title
+		}
+	}
+	return title
+}
+
+// NextConservationReward returns the next reward still to be reached
+func (p *Player) NextConservationReward() (ConservationReward, bool) {
+	for _, reward := range GetConservationRewards() {
+		if p.Conservation < reward.Score {
+			return reward, true
+		}
+	}
+	return ConservationReward{}, false
+}
@@ -0,0 +1,116 @@
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// Dish is a meal cooked from the catch that gives a timed buff when eaten
+type Dish struct {
+	Name        string
+	Description string
+	Ingredients []Ingredient
+	Buff        string        // "catch_chance", "legendary" or "bite_speed"
+	Strength    float64       // Size of the buff, see BuffDescription
+	Duration    time.Duration // How long the buff lasts
+}
+
+// GetAllDishes returns every dish that can be cooked in the kitchen
+func GetAllDishes() []Dish {
+	return []Dish{
+		{
+			Name:        "Grilled Trout",
+			Description: "Simple and hearty - a steady hand on the rod",
+			Ingredients: []Ingredient{{"Trout", 2}, {"Seaweed Clump", 1}},
+			Buff:        "catch_chance",
+			Strength:    0.2,
+			Duration:    10 * time.Minute,
+		},
+		{
+			Name:        "Minnow Fritters",
+			Description: "A quick snack while you wait for a bite",
+			Ingredients: []Ingredient{{"Minnow", 5}},
+			Buff:        "bite_speed",
+			Strength:    0.15,
+			Duration:    5 * time.Minute,
+		},
+		{
+			Name:        "Catfish Stew",
+			Description: "Slow-cooked for a long day on the water",
+			Ingredients: []Ingredient{{"Catfish", 2}, {"Bluegill", 1}},
+			Buff:        "catch_chance",
+			Strength:    0.1,
+			Duration:    30 * time.Minute,
+		},
+		{
+			Name:        "Fish Tacos",
+			Description: "Eaten with one hand, so the other stays on the reel",
+			Ingredients: []Ingredient{{"Bass", 1}, {"Perch", 2}},
+			Buff:        "bite_speed",
+			Strength:    0.25,
+			Duration:    10 * time.Minute,
+		},
+		{
+			Name:        "Sushi Platter",
+			Description: "Said to draw the attention of things from the deep",
+			Ingredients: []Ingredient{{"Salmon", 1}, {"Tuna", 1}, {"Seaweed Clump", 2}},
+			Buff:        "legendary",
+			Strength:    0.005,
+			Duration:    15 * time.Minute,
+		},
+	}
+}
+
+// GetDishByName returns the dish with the given name
+func GetDishByName(name string) (Dish, bool) {
+	for _, dish := range GetAllDishes() {
+		if dish.Name == name {
+			return dish, true
+		}
+	}
+	return Dish{}, false
+}
+
+// BuffDescription describes what eating the dish does
+func (d Dish) BuffDescription() string {
+	switch d.Buff {
+	case "catch_chance":
+		return "+" + formatPercent(d.Strength) + " catch chance"
+	case "legendary":
+		return "+" + formatPercent(d.Strength) + " legendary odds"
+	case "bite_speed":
+		return formatPercent(d.Strength) + " faster bites"
+	}
+	return ""
+}
+
+// formatPercent formats a fraction as a percentage, keeping a decimal for small ones
+func formatPercent(fraction float64) string {
+	percent := fraction * 100
+	if percent < 1 {
+		return fmt.Sprintf("%.1f%%", percent)
+	}
+	return fmt.Sprintf("%.0f%%", percent)
+}
+
+// Cook makes a dish from the inventory and puts it in the pantry
+func (p *Player) Cook(dish Dish) bool {
+	if !p.UseIngredients(dish.Ingredients) {
+		return false
+	}
+
+	if p.Pantry == nil {
+		p.Pantry = make(map[string]int)
+	}
+	p.Pantry[dish.Name]++
+	return true
+}
+
+// EatDish takes a dish out of the pantry to eat it
+func (p *Player) EatDish(name string) bool {
+	if p.Pantry[name] == 0 {
+		return false
+	}
+	p.Pantry[name]--
+	return true
+}
@@ -0,0 +1,200 @@
+package game
+
+// Ingredient is an amount of one catch needed by a recipe
+type Ingredient struct {
+	Name  string // Species or trash item from the inventory
+	Count int
+}
+
+// Recipe turns catches from the inventory into something useful
+type Recipe struct {
+	Name        string
+	Kind        string // "bait", "gear", "decoration" or "consumable"
+	Description string
+	Ingredients []Ingredient
+	Output      string // Bait added to the stock, or rod a gear recipe builds
+	Quantity    int    // Amount of bait made, 1 for everything else
+	Icon        string // How a decoration looks on the aquarium floor
+}
+
+// GetAllRecipes returns every crafting recipe
+func GetAllRecipes() []Recipe {
+	return []Recipe{
+		{
+			Name:        "Bait Trap",
+			Kind:        "bait",
+			Description: "A can trap that fills up with shrimp",
+			Ingredients: []Ingredient{{"Tin Can", 2}, {"Plastic Bottle", 1}},
+			Output:      "Shrimp",
+			Quantity:    10,
+		},
+		{
+			Name:        "Minnow Bucket",
+			Kind:        "bait",
+			Description: "Keep small catches alive to use as bait",
+			Ingredients: []Ingredient{{"Minnow", 3}},
+			Output:      "Live Minnow",
+			Quantity:    5,
+		},
+		{
+			Name:        "Rod Repair Kit",
+			Kind:        "consumable",
+			Description: "Fully repairs your rod when used",
+			Ingredients: []Ingredient{{"Broken Fishing Rod", 1}, {"Driftwood", 1}},
+			Quantity:    1,
+		},
+		{
+			Name:        "Salvaged Rod",
+			Kind:        "gear",
+			Description: "A Fiberglass Rod pieced together from other people's bad luck",
+			Ingredients: []Ingredient{{"Broken Fishing Rod", 3}, {"Driftwood", 2}},
+			Output:      "Fiberglass Rod",
+			Quantity:    1,
+		},
+		{
+			Name:        "Driftwood Arch",
+			Kind:        "decoration",
+			Description: "An arch for your aquarium fish to swim through",
+			Ingredients: []Ingredient{{"Driftwood", 3}},
+			Quantity:    1,
+			Icon:        "∩",
+		},
+		{
+			Name:        "Tire Reef",
+			Kind:        "decoration",
+			Description: "An old tire overgrown with seaweed",
+			Ingredients: []Ingredient{{"Car Tire", 1}, {"Seaweed Clump", 2}},
+			Quantity:    1,
+			Icon:        "◎",
+		},
+		{
+			Name:        "Boot Planter",
+			Kind:        "decoration",
+			Description: "Seaweed growing out of an old boot",
+			Ingredients: []Ingredient{{"Old Boot", 1}, {"Seaweed Clump", 1}},
+			Quantity:    1,
+			Icon:        "ψ",
+		},
+	}
+}
+
+// GetRecipeByName returns the recipe with the given name
+func GetRecipeByName(name string) (Recipe, bool) {
+	for _, recipe := range GetAllRecipes() {
+		if recipe.Name == name {
+			return recipe, true
+		}
+	}
+	return Recipe{}, false
+}
+
+// CountFish returns how many of a species or trash item are in the inventory
+func (p *Player) CountFish(name string) int {
+	count := 0
+	for _, fish := range p.FishCaught {
+		if fish.Name == name {
+			count++
+		}
+	}
+	return count
+}
+
+// HasIngredients reports whether the inventory holds everything in the list
+func (p *Player) HasIngredients(ingredients []Ingredient) bool {
+	for _, ingredient := range ingredients {
+		if p.CountFish(ingredient.Name) < ingredient.Count {
+			return false
+		}
+	}
+	return true
+}
+
+// UseIngredients takes the listed catches out of the inventory, lightest first
+func (p *Player) UseIngredients(ingredients []Ingredient) bool {
+	if !p.HasIngredients(ingredients) {
+		return false
+	}
+
+	for _, ingredient := range ingredients {
+		for n := 0; n < ingredient.Count; n++ {
+			lightest := -1
+			for i, fish := range p.FishCaught {
+				if fish.Name == ingredient.Name && (lightest < 0 || fish.Weight < p.FishCaught[lightest].Weight) {
+					lightest = i
+				}
+			}
+			p.RemoveFish(lightest)
+		}
+	}
+	return true
+}
+
+// Craft makes a recipe from the inventory. Bait goes straight into the bait
+// stock, everything else is kept with the player's crafted items.
+func (p *Player) Craft(recipe Recipe) bool {
+	if !p.UseIngredients(recipe.Ingredients) {
+		return false
+	}
+
+	if recipe.Kind == "bait" {
+		if p.BaitStock == nil {
+			p.BaitStock = make(map[string]int)
+		}
+		p.BaitStock[recipe.Output] += recipe.Quantity
+		return true
+	}
+
+	if p.Crafted == nil {
+		p.Crafted = make(map[string]int)
+	}
+	p.Crafted[recipe.Name] += recipe.Quantity
+	return true
+}
+
+// UseCrafted uses up a crafted consumable or fits a crafted piece of gear,
+// unless the equipped rod is already better. Decorations stay in the
+// aquarium and can't be used.
+func (p *Player) UseCrafted(name string) bool {
+	recipe, ok := GetRecipeByName(name)
+	if !ok || p.Crafted[name] == 0 {
+		return false
+	}
+
+	switch recipe.Kind {
+	case "consumable":
+		if name == "Rod Repair Kit" {
+			if p.RodWear == 0 {
+				return false
+			}
+			p.RodWear = 0
+		}
+	case "gear":
+		// Never swap a better rod for a crafted one
+		rod := GetRodByName(recipe.Output)
+		if p.Rod().Strength > rod.Strength {
+			return false
+		}
+		p.FishingRod = rod.Name
+		p.RodStrength = rod.Strength
+		p.RodWear = 0
+	default:
+		return false
+	}
+
+	p.Crafted[name]--
+	return true
+}
+
+// Decorations returns the crafted decorations the player owns, one entry each
+func (p *Player) Decorations() []Recipe {
+	decorations := []Recipe{}
+	for _, recipe := range GetAllRecipes() {
+		if recipe.Kind != "decoration" {
+			continue
+		}
+		for n := 0; n < p.Crafted[recipe.Name]; n++ {
+			decorations = append(decorations, recipe)
+		}
+	}
+	return decorations
+}
@@ -0,0 +1,101 @@
+package game
+
+import (
+	"math"
+	"time"
+)
+
+// Population is how much of a species' stock is left at one location, as a
+// fraction of what the waters there can hold
+type Population struct {
+	Level   float64   // 1 is a full, healthy stock
+	Updated time.Time // When Level was last worked out
+}
+
+// Ecosystem holds the populations of every fished species, by location and then species
+type Ecosystem map[string]map[string]Population
+
+// A few fish always survive, so even a collapsed stock can recover
+const minPopulation = 0.02
+
+// Capacity returns how many of a species a location holds when its stock is
+// full. Common fish are plentiful, rare ones take few catches to wipe out.
+func Capacity(fish Fish) int {
+	return 10 * fish.Rarity
+}
+
+// GrowthRate returns how fast a species' stock recovers, per hour.
+// Common fish breed quickly, rare ones take days.
+func GrowthRate(fish Fish) float64 {
+	return 0.02 + 0.04*float64(fish.Rarity)
+}
+
+// hasPopulation reports whether a species is tracked by the ecosystem.
+// Legendary creatures and trash never run out.
+func hasPopulation(fish Fish) bool {
+	return !fish.IsTrash && !fish.IsLegendary
+}
+
+// At returns the population after growing logistically since it was last
+// worked out, at the given rate per hour
+func (p Population) At(now time.Time, rate float64) float64 {
+	hours := now.Sub(p.Updated).Hours()
+	if hours <= 0 || p.Level >= 1 {
+		return p.Level
+	}
+
+	// Solution of dN/dt = rate * N * (1 - N)
+	level := math.Max(p.Level, minPopulation)
+	return 1 / (1 + (1-level)/level*math.Exp(-rate*hours))
+}
+
+// PopulationLevel returns how much of a species' stock is left at a location
+func (p *Player) PopulationLevel(location string, fish Fish, now time.Time) float64 {
+	population, ok := p.Populations[location][fish.Name]
+	if !hasPopulation(fish) || !ok {
+		return 1
+	}
+	return population.At(now, GrowthRate(fish))
+}
+
+// adjustPopulation changes a species' stock at a location by a number of fish
+func (p *Player) adjustPopulation(location string, fish Fish, fishCount float64, now time.Time) {
+	if !hasPopulation(fish) {
+		return
+	}
+
+	level := p.PopulationLevel(location, fish, now) + fishCount/float64(Capacity(fish))
+	level = math.Max(minPopulation, math.Min(1, level))
+
+	if p.Populations == nil {
+		p.Populations = Ecosystem{}
+	}
+	if p.Populations[location] == nil {
+		p.Populations[location] = map[string]Population{}
+	}
+	p.Populations[location][fish.Name] = Population{Level: level, Updated: now}
+}
+
+// Deplete takes a caught fish out of its species' stock at a location
+func (p *Player) Deplete(location string, fish Fish, now time.Time) {
+	p.adjustPopulation(location, fish, -1, now)
+}
+
+// Restock puts a released fish back into its species' stock at a location
+func (p *Player) Restock(location string, fish Fish, now time.Time) {
+	p.adjustPopulation(location, fish, 1, now)
+}
+
+// PopulationHealth describes a stock level
+func PopulationHealth(level float64) string {
+	switch {
+	case level >= 0.75:
+		return "Healthy"
+	case level >= 0.4:
+		return "Stressed"
+	case level >= 0.15:
+		return "Overfished"
+	default:
+		return "Collapsed"
+	}
+}
@@ -0,0 +1,190 @@
+package game
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// WorldEvent is something happening in the world that changes the fishing
+// while it lasts. Scheduled events come round on set days, the others start
+// at random.
+type WorldEvent struct {
+	Name           string
+	Icon           string
+	Description    string
+	Duration       time.Duration
+	Chance         float64        // Chance of starting in any given hour, 0 for scheduled events
+	Weekdays       []time.Weekday // Days a scheduled event starts on
+	StartHour      int            // Hour a scheduled event starts at
+	CatchFactor    float64        // Multiplier for the chance of a bite, 0 for no change
+	TrashBonus     float64        // Extra chance of hooking trash
+	LegendaryBonus float64        // Extra chance of hooking a legendary creature
+	Species        map[string]int // Species drawn in (or driven off) and the rarity weight it adds
+	PriceFactor    float64        // Multiplier for what fish sell for, 0 for no change
+}
+
+// ActiveEvent is one occurrence of a world event. It carries its own
+// description so the same event can be told differently each time.
+type ActiveEvent struct {
+	Name        string
+	Description string
+	Started     time.Time
+	Ends        time.Time
+}
+
+// GetAllEvents returns every world event
+func GetAllEvents() []WorldEvent {
+	return []WorldEvent{
+		{
+			Name:        "Oil Spill",
+			Icon:        "🛢️",
+			Description: "A tanker has run aground. The water is thick with sludge.",
+			Duration:    6 * time.Hour,
+			Chance:      0.01,
+			CatchFactor: 0.7,
+			TrashBonus:  0.15,
+			Species:     map[string]int{"Catfish": 3, "Bullhead": 3, "Salmon": -4, "Trout": -4},
+			PriceFactor: 0.8,
+		},
+		{
+			Name:        "Gold Rush",
+			Icon:        "💰",
+			Description: "Prospectors are panning the river and paying top dollar for dinner.",
+			Duration:    4 * time.Hour,
+			Chance:      0.01,
+			Species:     map[string]int{"Goldfish": 6, "Golden Dorado": 4},
+			PriceFactor: 1.5,
+		},
+		{
+			Name:        "Crypto Boom",
+			Icon:        "📈",
+			Description: "Newly rich traders want exotic fish on their yachts.",
+			Duration:    3 * time.Hour,
+			Chance:      0.01,
+			Species:     map[string]int{"Marlin": 3, "Sailfish": 3, "Bluefin Tuna": 3},
+			PriceFactor: 1.3,
+		},
+		{
+			Name:           "Storm Front",
+			Icon:           "⛈️",
+			Description:    "A storm is churning up the deep. Fewer bites, but something big is stirring.",
+			Duration:       2 * time.Hour,
+			Chance:         0.02,
+			CatchFactor:    0.8,
+			LegendaryBonus: 0.01,
+			Species:        map[string]int{"Giant Squid": 3, "Tarpon": 2},
+		},
+		{
+			Name:        "Salmon Run",
+			Icon:        "🐟",
+			Description: "The salmon are heading upstream to spawn.",
+			Duration:    24 * time.Hour,
+			Weekdays:    []time.Weekday{time.Sunday},
+			Species:     map[string]int{"Salmon": 8, "Rainbow Trout": 3, "Lake Trout": 3},
+		},
+		{
+			Name:        "Fish Market Day",
+			Icon:        "🏪",
+			Description: "The weekend market is open and buyers are paying extra.",
+			Duration:    8 * time.Hour,
+			Weekdays:    []time.Weekday{time.Saturday},
+			StartHour:   6,
+			PriceFactor: 1.25,
+		},
+	}
+}
+
+// GetEventByName returns the world event with the given name
+func GetEventByName(name string) (WorldEvent, bool) {
+	for _, event := range GetAllEvents() {
+		if event.Name == name {
+			return event, true
+		}
+	}
+	return WorldEvent{}, false
+}
+
+// Start begins an occurrence of the event at the given time
+func (e WorldEvent) Start(now time.Time) ActiveEvent {
+	return ActiveEvent{
+		Name:        e.Name,
+		Description: e.Description,
+		Started:     now,
+		Ends:        now.Add(e.Duration),
+	}
+}
+
+// IsScheduled reports whether the event comes round on set days
+// rather than starting at random
+func (e WorldEvent) IsScheduled() bool {
+	return len(e.Weekdays) > 0
+}
+
+// scheduledAt returns the occurrence of a scheduled event running at the
+// given time, if there is one. Occurrences can run on past midnight.
+func (e WorldEvent) scheduledAt(now time.Time) (ActiveEvent, bool) {
+	for daysBack := 0; time.Duration(daysBack)*24*time.Hour <= e.Duration; daysBack++ {
+		day := now.AddDate(0, 0, -daysBack)
+		start := time.Date(day.Year(), day.Month(), day.Day(), e.StartHour, 0, 0, 0, now.Location())
+		for _, weekday := range e.Weekdays {
+			if start.Weekday() == weekday && !now.Before(start) && now.Before(start.Add(e.Duration)) {
+				return e.Start(start), true
+			}
+		}
+	}
+	return ActiveEvent{}, false
+}
+
+// IsRunning reports whether the occurrence is still going on
+func (a ActiveEvent) IsRunning(now time.Time) bool {
+	return !now.Before(a.Started) && now.Before(a.Ends)
+}
+
+// Event returns the world event this is an occurrence of
+func (a ActiveEvent) Event() WorldEvent {
+	event, _ := GetEventByName(a.Name)
+	return event
+}
+
+// CurrentEvents returns the scheduled events running now along with the
+// random events that were started and haven't ended yet
+func CurrentEvents(started []ActiveEvent, now time.Time) []ActiveEvent {
+	current := []ActiveEvent{}
+	for _, event := range GetAllEvents() {
+		if occurrence, ok := event.scheduledAt(now); ok {
+			current = append(current, occurrence)
+		}
+	}
+	for _, occurrence := range started {
+		if occurrence.IsRunning(now) {
+			current = append(current, occurrence)
+		}
+	}
+	return current
+}
+
+// RollEvents gives every random event that isn't already running its chance
+// of starting over the given number of hours, and returns the ones that start
+func RollEvents(current []ActiveEvent, hours float64, now time.Time) []ActiveEvent {
+	running := map[string]bool{}
+	for _, occurrence := range current {
+		running[occurrence.Name] = true
+	}
+
+	started := []ActiveEvent{}
+	for _, event := range GetAllEvents() {
+		if event.IsScheduled() || running[event.Name] {
+			continue
+		}
+		if rand.Float64() < 1-math.Pow(1-event.Chance, hours) {
+			started = append(started, event.Start(now))
+		}
+	}
+	return started
+}
+
+// SalePrice returns what a catch sells for at the given price factor
+func SalePrice(value int, priceFactor float64) int {
+	return int(math.Round(float64(value) * priceFactor))
+}
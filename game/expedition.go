@@ -0,0 +1,112 @@
+package game
+
+import "time"
+
+// Region is a fishing ground a boat can be sent to on an expedition
+type Region struct {
+	Name        string
+	Tier        int      // Boat tier needed to get there
+	Habitats    []string // Habitats fished on the way
+	Description string
+}
+
+// GetAllRegions returns every region expeditions can be sent to
+func GetAllRegions() []Region {
+	return []Region{
+		{"Coral Coast", 1, []string{"Reef", "Coastal", "Flats", "Tropical Ocean"}, "Warm shallows full of reef fish"},
+		{"Open Ocean", 1, []string{"Open Ocean", "Ocean"}, "Blue water where the tuna run"},
+		{"Deep Ocean", 2, []string{"Deep Ocean", "Deep Sea", "Ocean Floor"}, "Cold, dark water and very big fish"},
+		{"The Abyss", 3, []string{"Abyss", "Hadal Zone", "Undersea Cave"}, "Where no light reaches, and things lurk"},
+	}
+}
+
+// GetRegionByName returns the region with the given name
+func GetRegionByName(name string) (Region, bool) {
+	for _, region := range GetAllRegions() {
+		if region.Name == name {
+			return region, true
+		}
+	}
+	return Region{}, false
+}
+
+// Fish returns the catalog entries living in the region, legendary creatures
+// included
+func (r Region) Fish() []Fish {
+	fishList := []Fish{}
+	for _, fish := range GetAllFish() {
+		if fish.IsTrash {
+			continue
+		}
+		for _, habitat := range r.Habitats {
+			if fish.Habitat == habitat {
+				fishList = append(fishList, fish)
+			}
+		}
+	}
+	return fishList
+}
+
+// Expedition is a boat out fishing on its own
+type Expedition struct {
+	Region   string
+	Boat     string
+	Started  time.Time
+	Duration time.Duration
+}
+
+// ReturnsAt returns when the boat will be back
+func (e Expedition) ReturnsAt() time.Time {
+	return e.Started.Add(e.Duration)
+}
+
+// IsBack reports whether the boat has returned
+func (e Expedition) IsBack(now time.Time) bool {
+	return !now.Before(e.ReturnsAt())
+}
+
+// ExpeditionReport describes the haul an expedition brought back
+type ExpeditionReport struct {
+	Expedition Expedition
+	Casts      int    // Lines the crew put out
+	Catches    []Fish // Everything landed, including containers
+	Loot       []LootDrop
+}
+
+// TotalWeight returns the combined weight of the haul
+func (r ExpeditionReport) TotalWeight() int {
+	total := 0
+	for _, fish := range r.Catches {
+		total += fish.Weight
+	}
+	return total
+}
+
+// TotalValue returns the combined value of the haul
+func (r ExpeditionReport) TotalValue() int {
+	total := 0
+	for _, fish := range r.Catches {
+		total += fish.Value
+	}
+	return total
+}
+
+// BoatAway reports whether a boat is out on an expedition
+func (p *Player) BoatAway(name string) bool {
+	return p.Expedition != nil && p.Expedition.Boat == name
+}
+
+// StartExpedition sends a boat to a region, paying for its fuel up front.
+// The boat can't be fished from until it's back.
+func (p *Player) StartExpedition(region Region, boat Boat, duration time.Duration, fuel int, now time.Time) bool {
+	if p.Expedition != nil || !p.OwnsBoat(boat.Name) || boat.Tier < region.Tier || p.Money < fuel {
+		return false
+	}
+
+	p.Money -= fuel
+	p.Expedition = &Expedition{Region: region.Name, Boat: boat.Name, Started: now, Duration: duration}
+	if p.Boat == boat.Name {
+		p.Boat = ""
+	}
+	return true
+}
@@ -0,0 +1,179 @@
+package game
+
+import "time"
+
+// Festival is a seasonal celebration that comes round once a year, on a
+// date from either the Gregorian or the lunar calendar. While it's on its
+// own species turn up and its legendary creature comes close to the shore.
+type Festival struct {
+	Name           string
+	Calendar       string // "Lunar" or "Gregorian", the calendar its date is kept by
+	Icon           string
+	Description    string
+	Color          string                   // Background of the festival's banner
+	Start          func(year int) time.Time // First day of the festival in a given year
+	Days           int                      // How many days it lasts
+	Species        []Fish                   // Fish only found while the festival is on
+	Legendary      string                   // Legendary creature drawn in while it's on
+	LegendaryBonus float64                  // Extra chance of hooking a legendary creature
+}
+
+// gregorianDate returns a festival start on the same date every year
+func gregorianDate(month time.Month, day int) func(year int) time.Time {
+	return func(year int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+	}
+}
+
+// GetAllFestivals returns every festival in the order they fall in the year
+func GetAllFestivals() []Festival {
+	return []Festival{
+		{
+			Name:        "Tết",
+			Calendar:    "Lunar",
+			Icon:        "🧧",
+			Description: "The Lunar New Year! The Golden Carp swims into the shallows to bring luck.",
+			Color:       "#D7263D",
+			Start:       LunarNewYear,
+			Days:        3,
+			Species: []Fish{
+				{"Lucky Koi", 6, 5, 60, "A Lucky Koi! Its red and gold scales promise a prosperous new year!", "Red", "Patterned", "Pond", "", false, false, Catch{}},
+				{"Jade Perch", 3, 6, 35, "A Jade Perch, green as the new year's first shoots!", "Green", "Plain", "Lake", "", false, false, Catch{}},
+				{"Lantern Goby", 1, 7, 25, "A little Lantern Goby, glowing like a festival lantern!", "Orange", "Spotted", "Stream", "", false, false, Catch{}},
+			},
+			Legendary:      "Golden Carp",
+			LegendaryBonus: 0.03,
+		},
+		{
+			Name:        "Midsummer",
+			Calendar:    "Gregorian",
+			Icon:        "☀️",
+			Description: "The longest days of the year. The water is warm and the Phoenix Fish stirs.",
+			Color:       "#F4A259",
+			Start:       gregorianDate(time.June, 20),
+			Days:        5,
+			Species: []Fish{
+				{"Sunfire Perch", 3, 6, 30, "A Sunfire Perch, blazing orange in the midsummer sun!", "Fiery Red", "Striped", "Lake", "", false, false, Catch{}},
+				{"Solstice Pike", 9, 4, 70, "A Solstice Pike! It only hunts on the longest days.", "Gold", "Striped", "Lake", "", false, false, Catch{}},
+			},
+			Legendary:      "Phoenix Fish",
+			LegendaryBonus: 0.01,
+		},
+		{
+			Name:        "Harvest Moon",
+			Calendar:    "Lunar",
+			Icon:        "🏮",
+			Description: "The full moon nearest the equinox. Lanterns float on the water and moonlit creatures rise.",
+			Color:       "#E09F3E",
+			Start: func(year int) time.Time {
+				// The day before the full moon to the day after
+				return HarvestMoon(year).AddDate(0, 0, -1)
+			},
+			Days: 3,
+			Species: []Fish{
+				{"Mooncake Bream", 3, 6, 35, "A Mooncake Bream, round and golden as the harvest moon!", "Bronze", "Patterned", "Freshwater", "", false, false, Catch{}},
+				{"Lanternfish", 1, 7, 25, "A Lanternfish, its lights twinkling like a lantern parade!", "Silver", "Bioluminescent", "Pond", "", false, false, Catch{}},
+			},
+			Legendary:      "Moonlight Jellyfish",
+			LegendaryBonus: 0.02,
+		},
+		{
+			Name:        "Halloween",
+			Calendar:    "Gregorian",
+			Icon:        "🎃",
+			Description: "Something spooky is stirring in the water. The Ghost Whale walks the waves.",
+			Color:       "#FF7518",
+			Start:       gregorianDate(time.October, 29),
+			Days:        4,
+			Species: []Fish{
+				{"Pumpkinseed", 1, 7, 20, "A Pumpkinseed! Orange as a jack-o'-lantern.", "Orange", "Spotted", "Pond", "", false, false, Catch{}},
+				{"Ghost Carp", 6, 4, 65, "A Ghost Carp, pale as a sheet!", "White", "Translucent", "Freshwater", "", false, false, Catch{}},
+			},
+			Legendary:      "Ghost Whale",
+			LegendaryBonus: 0.01,
+		},
+	}
+}
+
+// GetFestivalByName returns the festival with the given name
+func GetFestivalByName(name string) (Festival, bool) {
+	for _, festival := range GetAllFestivals() {
+		if festival.Name == name {
+			return festival, true
+		}
+	}
+	return Festival{}, false
+}
+
+// Dates returns when the festival starts and ends in a given year
+func (f Festival) Dates(year int) (time.Time, time.Time) {
+	start := f.Start(year)
+	return start, start.AddDate(0, 0, f.Days)
+}
+
+// Next returns the festival that's on now, or the next one to come
+func (f Festival) Next(now time.Time) (time.Time, time.Time) {
+	for year := now.Year() - 1; ; year++ {
+		if start, end := f.Dates(year); now.Before(end) {
+			return start, end
+		}
+	}
+}
+
+// IsOn reports whether the festival is being celebrated at the given time
+func (f Festival) IsOn(now time.Time) bool {
+	start, _ := f.Next(now)
+	return !now.Before(start)
+}
+
+// Collection returns the names of everything to catch for the festival's collection
+func (f Festival) Collection() []string {
+	names := []string{}
+	for _, fish := range f.Species {
+		names = append(names, fish.Name)
+	}
+	if f.Legendary != "" {
+		names = append(names, f.Legendary)
+	}
+	return names
+}
+
+// CurrentFestivals returns the festivals that are on at the given time
+func CurrentFestivals(now time.Time) []Festival {
+	current := []Festival{}
+	for _, festival := range GetAllFestivals() {
+		if festival.IsOn(now) {
+			current = append(current, festival)
+		}
+	}
+	return current
+}
+
+// HasFestivalFish reports whether a fish is in the player's collection for a festival
+func (p *Player) HasFestivalFish(festival, name string) bool {
+	for _, collected := range p.Festivals[festival] {
+		if collected == name {
+			return true
+		}
+	}
+	return false
+}
+
+// CollectFestivalFish adds a catch to the collection of any festival that's
+// on and wants it. Returns the festivals whose collection it was new to.
+func (p *Player) CollectFestivalFish(fish Fish, now time.Time) []Festival {
+	added := []Festival{}
+	for _, festival := range CurrentFestivals(now) {
+		for _, name := range festival.Collection() {
+			if name != fish.Name || p.HasFestivalFish(festival.Name, name) {
+				continue
+			}
+			if p.Festivals == nil {
+				p.Festivals = map[string][]string{}
+			}
+			p.Festivals[festival.Name] = append(p.Festivals[festival.Name], name)
+			added = append(added, festival)
+		}
+	}
+	return added
+}
@@ -0,0 +1,32 @@
+package game
+
+// Rod represents a fishing rod that can be bought in the shop
+type Rod struct {
+	Name          string
+	Cost          int
+	Strength      int // Bonus added to the catch chance
+	MaxWeight     int // Heaviest fish (in lbs) the line is rated for
+	MaxDurability int // Durability when new or fully repaired
+}
+
+// GetAllRods returns every rod available in the game, cheapest first
+func GetAllRods() []Rod {
+	return []Rod{
+		{"Basic Rod", 0, 1, 40, 100},
+		{"Fiberglass Rod", 200, 2, 100, 150},
+		{"Carbon Fiber Rod", 750, 3, 300, 200},
+		{"Deep Sea Rod", 2500, 4, 800, 300},
+		{"Titan Rod", 8000, 5, 2500, 500},
+	}
+}
+
+// GetRodByName returns the rod with the given name, falling back to the Basic Rod
+func GetRodByName(name string) Rod {
+	rods := GetAllRods()
+	for _, rod := range rods {
+		if rod.Name == name {
+			return rod
+		}
+	}
+	return rods[0]
+}
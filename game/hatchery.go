@@ -0,0 +1,266 @@
+package game
+
+import (
+	"math"
+	"time"
+)
+
+// PondTier is a kind of fish-farm pond
+type PondTier struct {
+	Name     string
+	Cost     int
+	Capacity int     // Number of fish the pond holds, young ones included
+	Growth   float64 // Multiplier for how fast fish breed and grow in it
+}
+
+// GetAllPonds returns every pond that can be built, cheapest first
+func GetAllPonds() []PondTier {
+	return []PondTier{
+		{"Backyard Pond", 400, 6, 1},
+		{"Farm Pond", 1500, 12, 1.25},
+		{"Hatchery", 5000, 24, 1.5},
+	}
+}
+
+// Pond is a farm pond stocked with a single species. Adults breed in pairs
+// and their young grow up into adults over real time.
+type Pond struct {
+	Tier      int       // Index of the pond in GetAllPonds
+	Species   string    // Species stocked, "" while the pond is empty
+	Adults    int       // Grown fish, ready to breed or harvest
+	Young     []float64 // How far each young fish has grown, from 0 to 1
+	Spawning  float64   // Progress towards the next young fish
+	UpdatedAt time.Time // When the pond was last brought up to date
+}
+
+// Fish that stay in a pond when it's harvested, to keep it breeding
+const breedingPair = 2
+
+// Kind returns the catalog entry for the pond
+func (p *Pond) Kind() PondTier {
+	ponds := GetAllPonds()
+	if p.Tier < 0 || p.Tier >= len(ponds) {
+		return ponds[0]
+	}
+	return ponds[p.Tier]
+}
+
+// Count returns how many fish are in the pond, young ones included
+func (p *Pond) Count() int {
+	return p.Adults + len(p.Young)
+}
+
+// IsFull reports whether there's no room left in the pond
+func (p *Pond) IsFull() bool {
+	return p.Count() >= p.Kind().Capacity
+}
+
+// GrowHours returns how long a young fish takes to grow up. Common fish
+// grow up in a couple of hours, rare ones take most of a day.
+func GrowHours(fish Fish) float64 {
+	return 2 * float64(11-fish.Rarity)
+}
+
+// SpawnHours returns how long a pair of adults takes to have a young fish
+func SpawnHours(fish Fish) float64 {
+	return float64(12 - fish.Rarity)
+}
+
+// findSpecies returns the catalog entry of a species, including the ones
+// only found during festivals
+func findSpecies(name string) (Fish, bool) {
+	for _, fish := range GetAllFish() {
+		if fish.Name == name {
+			return fish, true
+		}
+	}
+	for _, festival := range GetAllFestivals() {
+		for _, fish := range festival.Species {
+			if fish.Name == name {
+				return fish, true
+			}
+		}
+	}
+	return Fish{}, false
+}
+
+// CanStock reports whether a fish can go in the pond. Trash and legendary
+// creatures can't be farmed, and a pond only holds one species. Rare
+// variants stay out too, since a pond only breeds the plain kind and the
+// variant would be lost.
+func (p *Pond) CanStock(fish Fish) bool {
+	if fish.IsTrash || fish.IsLegendary || fish.Catch.Variant != "" || p.IsFull() {
+		return false
+	}
+	if _, ok := findSpecies(fish.Name); !ok {
+		return false
+	}
+	return p.Species == "" || p.Species == fish.Name
+}
+
+// Grow brings the pond up to the given time: young fish grow up, and every
+// pair of adults breeds while there's room. Returns how many fish grew up.
+func (p *Pond) Grow(now time.Time) int {
+	hours := now.Sub(p.UpdatedAt).Hours()
+	p.UpdatedAt = now
+	species, ok := findSpecies(p.Species)
+	if !ok || hours <= 0 {
+		return 0
+	}
+	hours *= p.Kind().Growth
+
+	// Go an hour at a time, so young born while the game was closed get
+	// to grow up too
+	grown := 0
+	for hours > 0 {
+		step := math.Min(hours, 1)
+		grown += p.grow(species, step)
+		hours -= step
+	}
+	return grown
+}
+
+// grow moves the pond on by a number of hours
+func (p *Pond) grow(species Fish, hours float64) int {
+	// Young fish grow up into adults
+	grown := 0
+	young := []float64{}
+	for _, progress := range p.Young {
+		progress += hours / GrowHours(species)
+		if progress >= 1 {
+			grown++
+			continue
+		}
+		young = append(young, progress)
+	}
+	p.Young = young
+	p.Adults += grown
+
+	// Pairs of adults have young while there's room for them
+	pairs := p.Adults / 2
+	if pairs == 0 || p.IsFull() {
+		p.Spawning = 0
+		return grown
+	}
+	p.Spawning += float64(pairs) * hours / SpawnHours(species)
+	for p.Spawning >= 1 && !p.IsFull() {
+		p.Spawning--
+		p.Young = append(p.Young, 0)
+	}
+	if p.IsFull() {
+		p.Spawning = 0
+	}
+	return grown
+}
+
+// Harvestable returns how many adults can be harvested, leaving a breeding
+// pair behind
+func (p *Pond) Harvestable() int {
+	if p.Adults <= breedingPair {
+		return 0
+	}
+	return p.Adults - breedingPair
+}
+
+// HarvestValue returns what the harvestable fish would sell for at a price factor
+func (p *Pond) HarvestValue(priceFactor float64) int {
+	species, ok := findSpecies(p.Species)
+	if !ok {
+		return 0
+	}
+	return SalePrice(species.Value*p.Harvestable(), priceFactor)
+}
+
+// NextPond returns the next pond that can be built, if there is one
+func (p *Player) NextPond() (PondTier, bool) {
+	ponds := GetAllPonds()
+	if len(p.Farm) >= len(ponds) {
+		return PondTier{}, false
+	}
+	return ponds[len(p.Farm)], true
+}
+
+// BuildPond buys the next pond for the farm
+func (p *Player) BuildPond(now time.Time) bool {
+	next, ok := p.NextPond()
+	if !ok || p.Money < next.Cost {
+		return false
+	}
+
+	p.Money -= next.Cost
+	p.Farm = append(p.Farm, Pond{Tier: len(p.Farm), UpdatedAt: now})
+	return true
+}
+
+// CanStockPond reports whether a fish from the inventory can go in a pond.
+// Fish kept against the rules can't, or they'd be hidden from the warden.
+func (p *Player) CanStockPond(pond Pond, fish Fish) bool {
+	if _, illegal := p.IllegalCatchFor(fish); illegal {
+		return false
+	}
+	return pond.CanStock(fish)
+}
+
+// StockPond moves a fish from the inventory into a pond
+func (p *Player) StockPond(pond, index int, now time.Time) bool {
+	if pond < 0 || pond >= len(p.Farm) || index < 0 || index >= len(p.FishCaught) {
+		return false
+	}
+	if !p.CanStockPond(p.Farm[pond], p.FishCaught[index]) {
+		return false
+	}
+
+	// Catch the pond up first so the new fish doesn't count as having been there all along
+	p.Farm[pond].Grow(now)
+	fish, _ := p.RemoveFish(index)
+	p.Farm[pond].Species = fish.Name
+	p.Farm[pond].Adults++
+	return true
+}
+
+// TendFarm brings every pond up to the given time. Returns how many fish
+// grew up across the farm.
+func (p *Player) TendFarm(now time.Time) int {
+	grown := 0
+	for i := range p.Farm {
+		grown += p.Farm[i].Grow(now)
+	}
+	return grown
+}
+
+// HarvestPond sells every adult in a pond apart from a breeding pair, at a
+// price factor set by whatever is going on at the market. Returns how many
+// were sold and the money made.
+func (p *Player) HarvestPond(pond int, priceFactor float64, now time.Time) (int, int) {
+	if pond < 0 || pond >= len(p.Farm) {
+		return 0, 0
+	}
+
+	p.Farm[pond].Grow(now)
+	count := p.Farm[pond].Harvestable()
+	money := p.Farm[pond].HarvestValue(priceFactor)
+	if count == 0 {
+		return 0, 0
+	}
+
+	p.Farm[pond].Adults -= count
+	p.Money += money
+	p.Stats.TotalSold += money
+	return count, money
+}
+
+// GrowthETA returns how long until the next young fish in a pond grows up,
+// and false if there are none growing
+func (p *Pond) GrowthETA() (time.Duration, bool) {
+	species, ok := findSpecies(p.Species)
+	if !ok || len(p.Young) == 0 {
+		return 0, false
+	}
+
+	most := 0.0
+	for _, progress := range p.Young {
+		most = math.Max(most, progress)
+	}
+	hours := (1 - most) * GrowHours(species) / p.Kind().Growth
+	return time.Duration(hours * float64(time.Hour)), true
+}
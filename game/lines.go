@@ -0,0 +1,84 @@
+package game
+
+// MaxLines is how many lines can be out at once, counting the main rod
+const MaxLines = 4
+
+// IdleLine is an extra line set in a holder, fishing on its own with its own
+// bait. Every line is rigged off the player's equipped rod: it casts with the
+// rod's strength, wears the rod down, and stops when the rod breaks.
+type IdleLine struct {
+	Bait string
+}
+
+// Price of each rod holder, in the order they are bought
+var rodHolderCosts = []int{400, 1500, 4000}
+
+// LineCount returns how many lines the player has out, counting the main rod
+func (p *Player) LineCount() int {
+	return 1 + len(p.Lines)
+}
+
+// NextRodHolderCost returns the price of the next rod holder, or false once
+// every holder has been bought
+func (p *Player) NextRodHolderCost() (int, bool) {
+	if p.LineCount() >= MaxLines {
+		return 0, false
+	}
+	return rodHolderCosts[len(p.Lines)], true
+}
+
+// BuyRodHolder buys another rod holder, which fishes with the equipped bait
+// until it's given its own
+func (p *Player) BuyRodHolder() bool {
+	cost, ok := p.NextRodHolderCost()
+	if !ok || p.Money < cost {
+		return false
+	}
+
+	p.Money -= cost
+	p.Lines = append(p.Lines, IdleLine{Bait: p.Bait})
+	return true
+}
+
+// LineBait returns the name of the bait on a line. Line 0 is the main rod.
+func (p *Player) LineBait(line int) string {
+	if line <= 0 || line > len(p.Lines) {
+		return p.Bait
+	}
+	return p.Lines[line-1].Bait
+}
+
+// SetLineBait puts a different kind of bait on a line. Line 0 is the main rod,
+// so this equips the bait.
+func (p *Player) SetLineBait(line int, baitName string) bool {
+	if line == 0 {
+		return p.EquipBait(baitName)
+	}
+	if line < 0 || line > len(p.Lines) {
+		return false
+	}
+
+	bait, ok := GetBaitByName(baitName)
+	if !ok || p.BaitStock[baitName] == 0 {
+		return false
+	}
+	p.Lines[line-1].Bait = bait.Name
+	return true
+}
+
+// UseLineBait uses up one piece of a line's bait for a cast. All lines share
+// the same bait box. It returns false, and a bare hook, when the bait has run out.
+func (p *Player) UseLineBait(line int) (BaitType, bool) {
+	if line == 0 {
+		return p.UseBait()
+	}
+
+	name := p.LineBait(line)
+	if p.BaitStock[name] == 0 {
+		return BaitType{}, false
+	}
+
+	p.BaitStock[name]--
+	bait, _ := GetBaitByName(name)
+	return bait, true
+}
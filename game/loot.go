@@ -0,0 +1,186 @@
+package game
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// LootEntry is one possible reward in a loot table
+type LootEntry struct {
+	Kind   string // "money", "bait", "chum", "gear" or "map_fragment"
+	Name   string // Bait, chum or rod the entry gives
+	Min    int    // Smallest amount given
+	Max    int    // Largest amount given
+	Weight int    // Relative chance of this entry being picked
+}
+
+// LootTable describes what can be found inside a container
+type LootTable struct {
+	Container string // Catalog name of the container, e.g. "Treasure Chest"
+	Rolls     int    // Number of rewards inside
+	Entries   []LootEntry
+}
+
+// LootDrop is a reward rolled from a loot table
+type LootDrop struct {
+	Kind   string // As LootEntry.Kind, or "treasure_map" when a map is completed
+	Name   string
+	Amount int
+}
+
+// Map fragments needed to piece together a treasure map
+const MapFragmentsNeeded = 4
+
+// GetAllLootTables returns the loot tables of every container
+func GetAllLootTables() []LootTable {
+	return []LootTable{
+		{
+			Container: "Treasure Chest",
+			Rolls:     2,
+			Entries: []LootEntry{
+				{"money", "", 30, 120, 40},
+				{"bait", "Squid", 5, 10, 20},
+				{"bait", "Glowing Lure", 1, 3, 5},
+				{"gear", "Fiberglass Rod", 1, 1, 10},
+				{"gear", "Carbon Fiber Rod", 1, 1, 5},
+				{"map_fragment", "", 1, 1, 20},
+			},
+		},
+		{
+			Container: "Sunken Crate",
+			Rolls:     1,
+			Entries: []LootEntry{
+				{"money", "", 10, 40, 40},
+				{"bait", "Shrimp", 5, 15, 30},
+				{"chum", "Chum Bucket", 1, 1, 15},
+				{"map_fragment", "", 1, 1, 15},
+			},
+		},
+		{
+			Container: "Message in a Bottle",
+			Rolls:     1,
+			Entries: []LootEntry{
+				{"money", "", 5, 25, 40},
+				{"map_fragment", "", 1, 1, 60},
+			},
+		},
+		{
+			// Dug up when a treasure map is completed, rather than fished up
+			Container: "Buried Treasure",
+			Rolls:     3,
+			Entries: []LootEntry{
+				{"money", "", 300, 800, 50},
+				{"bait", "Glowing Lure", 3, 5, 25},
+				{"gear", "Deep Sea Rod", 1, 1, 25},
+			},
+		},
+	}
+}
+
+// GetLootTable returns the loot table for a container, if the catch is one
+func GetLootTable(container string) (LootTable, bool) {
+	for _, table := range GetAllLootTables() {
+		if table.Container == container {
+			return table, true
+		}
+	}
+	return LootTable{}, false
+}
+
+// Roll picks the rewards inside a container
+func (t LootTable) Roll() []LootDrop {
+	totalWeight := 0
+	for _, entry := range t.Entries {
+		totalWeight += entry.Weight
+	}
+
+	drops := []LootDrop{}
+	for roll := 0; roll < t.Rolls && totalWeight > 0; roll++ {
+		randomNum := rand.Intn(totalWeight)
+		for _, entry := range t.Entries {
+			randomNum -= entry.Weight
+			if randomNum < 0 {
+				amount := entry.Min + rand.Intn(entry.Max-entry.Min+1)
+				drops = append(drops, LootDrop{Kind: entry.Kind, Name: entry.Name, Amount: amount})
+				break
+			}
+		}
+	}
+	return drops
+}
+
+// Describe returns a short description of the reward
+func (d LootDrop) Describe() string {
+	switch d.Kind {
+	case "money":
+		if d.Name != "" {
+			// A rod we didn't need, sold on the spot
+			return fmt.Sprintf("a %s (sold for $%d)", d.Name, d.Amount)
+		}
+		return fmt.Sprintf("$%d", d.Amount)
+	case "bait", "chum":
+		return fmt.Sprintf("%d %s", d.Amount, d.Name)
+	case "gear":
+		return "a " + d.Name
+	case "map_fragment":
+		return "a Map Fragment"
+	case "treasure_map":
+		return "a complete Treasure Map!"
+	}
+	return d.Name
+}
+
+// OpenContainer rolls a container's loot table and gives the rewards to the
+// player, returning what was actually received
+func (p *Player) OpenContainer(container string) []LootDrop {
+	table, ok := GetLootTable(container)
+	if !ok {
+		return nil
+	}
+	return p.ApplyLoot(table.Roll())
+}
+
+// ApplyLoot gives rewards to the player. A rod no better than the one in use
+// is sold on the spot, and a full set of map fragments is dug up straight away.
+func (p *Player) ApplyLoot(drops []LootDrop) []LootDrop {
+	received := []LootDrop{}
+
+	for _, drop := range drops {
+		switch drop.Kind {
+		case "money":
+			p.Money += drop.Amount
+		case "bait":
+			if p.BaitStock == nil {
+				p.BaitStock = make(map[string]int)
+			}
+			p.BaitStock[drop.Name] += drop.Amount
+		case "chum":
+			if p.ChumStock == nil {
+				p.ChumStock = make(map[string]int)
+			}
+			p.ChumStock[drop.Name] += drop.Amount
+		case "gear":
+			rod := GetRodByName(drop.Name)
+			if rod.Strength <= p.RodStrength {
+				drop = LootDrop{Kind: "money", Name: rod.Name, Amount: rod.Cost / 4}
+				p.Money += drop.Amount
+				break
+			}
+			p.FishingRod = rod.Name
+			p.RodStrength = rod.Strength
+			p.RodWear = 0
+		case "map_fragment":
+			p.MapFragments += drop.Amount
+		}
+		received = append(received, drop)
+	}
+
+	// Piece together a treasure map and dig up what it leads to
+	if p.MapFragments >= MapFragmentsNeeded {
+		p.MapFragments -= MapFragmentsNeeded
+		received = append(received, LootDrop{Kind: "treasure_map", Name: "Treasure Map", Amount: 1})
+		received = append(received, p.OpenContainer("Buried Treasure")...)
+	}
+
+	return received
+}
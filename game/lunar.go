@@ -0,0 +1,109 @@
+package game
+
+import (
+	"math"
+	"time"
+)
+
+// Moon phases are worked out with the periodic terms from Jean Meeus'
+// "Astronomical Algorithms" (chapter 49), which are good to within a few
+// minutes for centuries either side of 2000
+
+// Length of the average lunar month, in days
+const synodicMonth = 29.530588861
+
+// Vietnam keeps its lunar calendar on UTC+7, which is what decides the day Tết falls on
+var vietnamTime = time.FixedZone("ICT", 7*60*60)
+
+// julianDayToTime converts a Julian Day to a time
+func julianDayToTime(jd float64) time.Time {
+	seconds := (jd - 2440587.5) * 86400
+	return time.Unix(int64(math.Round(seconds)), 0).UTC()
+}
+
+// moonPhase returns the time of a new moon (phase 0) or full moon (phase 0.5),
+// k lunations on from the new moon of January 6, 2000
+func moonPhase(k float64) time.Time {
+	rad := math.Pi / 180
+	t := k / 1236.85
+
+	jd := 2451550.09766 + synodicMonth*k + 0.00015437*t*t - 0.000000150*t*t*t + 0.00000000073*t*t*t*t
+
+	e := 1 - 0.002516*t - 0.0000074*t*t
+	m := (2.5534 + 29.10535670*k - 0.0000014*t*t - 0.00000011*t*t*t) * rad
+	mp := (201.5643 + 385.81693528*k + 0.0107582*t*t + 0.00001238*t*t*t - 0.000000058*t*t*t*t) * rad
+	f := (160.7108 + 390.67050284*k - 0.0016118*t*t - 0.00000227*t*t*t + 0.000000011*t*t*t*t) * rad
+	omega := (124.7746 - 1.56375588*k + 0.0020672*t*t + 0.00000215*t*t*t) * rad
+
+	// The first two terms differ slightly between new and full moons
+	first, second, third, fourth, fifth := -0.40720, 0.17241, 0.01608, 0.01039, 0.00739
+	if k-math.Floor(k) != 0 {
+		first, second, third, fourth, fifth = -0.40614, 0.17302, 0.01614, 0.01043, 0.00734
+	}
+
+	jd += first*math.Sin(mp) +
+		second*e*math.Sin(m) +
+		third*math.Sin(2*mp) +
+		fourth*math.Sin(2*f) +
+		fifth*e*math.Sin(mp-m) -
+		0.00514*e*math.Sin(mp+m) +
+		0.00208*e*e*math.Sin(2*m) -
+		0.00111*math.Sin(mp-2*f) -
+		0.00057*math.Sin(mp+2*f) +
+		0.00056*e*math.Sin(2*mp+m) -
+		0.00042*math.Sin(3*mp) +
+		0.00042*e*math.Sin(m+2*f) +
+		0.00038*e*math.Sin(m-2*f) -
+		0.00024*e*math.Sin(2*mp-m) -
+		0.00017*math.Sin(omega)
+
+	return julianDayToTime(jd)
+}
+
+// lunationsBefore returns the number of lunations from January 2000 to a little before a time
+func lunationsBefore(t time.Time) float64 {
+	years := float64(t.Year()-2000) + float64(t.YearDay())/365.25
+	return math.Floor(years*12.3685) - 1
+}
+
+// NewMoon returns the first new moon at or after a time
+func NewMoon(after time.Time) time.Time {
+	for k := lunationsBefore(after); ; k++ {
+		if moon := moonPhase(k); !moon.Before(after) {
+			return moon
+		}
+	}
+}
+
+// FullMoon returns the first full moon at or after a time
+func FullMoon(after time.Time) time.Time {
+	for k := lunationsBefore(after) + 0.5; ; k++ {
+		if moon := moonPhase(k); !moon.Before(after) {
+			return moon
+		}
+	}
+}
+
+// localDate returns midnight, in local time, of the calendar day a time falls on in a zone
+func localDate(t time.Time, zone *time.Location) time.Time {
+	year, month, day := t.In(zone).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+// LunarNewYear returns the day of Tết, the Lunar New Year. It's the day of
+// the first new moon from January 21, counted on Vietnam's clock.
+func LunarNewYear(year int) time.Time {
+	earliest := time.Date(year, time.January, 21, 0, 0, 0, 0, vietnamTime)
+	return localDate(NewMoon(earliest), vietnamTime)
+}
+
+// HarvestMoon returns the day of the full moon nearest the September equinox
+func HarvestMoon(year int) time.Time {
+	equinox := time.Date(year, time.September, 23, 0, 0, 0, 0, time.UTC)
+	before := FullMoon(equinox.AddDate(0, 0, -30))
+	after := FullMoon(before.Add(time.Hour))
+	if equinox.Sub(before) < after.Sub(equinox) {
+		return localDate(before, time.Local)
+	}
+	return localDate(after, time.Local)
+}
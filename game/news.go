@@ -0,0 +1,202 @@
+package game
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Headline is one story from a news feed
+type Headline struct {
+	ID        string // Unique ID from the feed, or the title if it has none
+	Title     string
+	Published time.Time // Zero if the feed doesn't say
+}
+
+// NewsRule starts a world event when a headline mentions any of its keywords
+type NewsRule struct {
+	Keywords []string `json:"keywords"`
+	Event    string   `json:"event"`
+}
+
+// Headlines older than this are old news and don't start events
+const NewsMaxAge = 24 * time.Hour
+
+// GetDefaultNewsRules returns the keyword rules used when none are configured
+func GetDefaultNewsRules() []NewsRule {
+	return []NewsRule{
+		{[]string{"oil", "spill", "tanker", "pollution"}, "Oil Spill"},
+		{[]string{"gold", "prospector", "bullion"}, "Gold Rush"},
+		{[]string{"crypto", "bitcoin", "ethereum", "blockchain"}, "Crypto Boom"},
+		{[]string{"storm", "hurricane", "typhoon", "cyclone", "gale"}, "Storm Front"},
+	}
+}
+
+// ValidateNewsRules checks that every rule has keywords and starts an event that exists
+func ValidateNewsRules(rules []NewsRule) error {
+	for _, rule := range rules {
+		if _, ok := GetEventByName(rule.Event); !ok {
+			return fmt.Errorf("unknown event %q", rule.Event)
+		}
+		if len(rule.Keywords) == 0 {
+			return fmt.Errorf("no keywords for %q", rule.Event)
+		}
+	}
+	return nil
+}
+
+// normalizeWords lowercases text and reduces it to single-spaced words,
+// padded with a space on each side so whole words can be matched
+func normalizeWords(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return " " + strings.Join(words, " ") + " "
+}
+
+// Matches reports whether a headline mentions one of the rule's keywords.
+// Keywords match whole words, so "oil" doesn't match "boil".
+func (r NewsRule) Matches(title string) bool {
+	words := normalizeWords(title)
+	for _, keyword := range r.Keywords {
+		if strings.Contains(words, normalizeWords(keyword)) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchHeadline returns the event the first matching rule starts for a headline
+func MatchHeadline(rules []NewsRule, headline Headline) (WorldEvent, bool) {
+	for _, rule := range rules {
+		if rule.Matches(headline.Title) {
+			return GetEventByName(rule.Event)
+		}
+	}
+	return WorldEvent{}, false
+}
+
+// IsFresh reports whether a headline is recent enough to start an event.
+// Headlines without a date are taken to be new.
+func (h Headline) IsFresh(now time.Time) bool {
+	return h.Published.IsZero() || now.Sub(h.Published) < NewsMaxAge
+}
+
+// xmlFeed covers both RSS (channel items) and Atom (entries)
+type xmlFeed struct {
+	Items   []xmlItem `xml:"channel>item"`
+	Entries []xmlItem `xml:"entry"`
+}
+
+type xmlItem struct {
+	Title     string `xml:"title"`
+	GUID      string `xml:"guid"`
+	ID        string `xml:"id"`
+	PubDate   string `xml:"pubDate"`
+	Published string `xml:"published"`
+	Updated   string `xml:"updated"`
+}
+
+// jsonItem covers JSON Feed items and plain lists of headlines
+type jsonItem struct {
+	ID            string `json:"id"`
+	Title         string `json:"title"`
+	DatePublished string `json:"date_published"`
+	Published     string `json:"published"`
+	Date          string `json:"date"`
+}
+
+// ParseFeed reads the headlines from an RSS, Atom or JSON feed. JSON feeds
+// can be a JSON Feed document or just a list of items with titles.
+func ParseFeed(data []byte) ([]Headline, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, fmt.Errorf("feed is empty")
+	}
+
+	var headlines []Headline
+	switch data[0] {
+	case '[', '{':
+		items := []jsonItem{}
+		if data[0] == '[' {
+			if err := json.Unmarshal(data, &items); err != nil {
+				return nil, err
+			}
+		} else {
+			var feed struct {
+				Items []jsonItem `json:"items"`
+			}
+			if err := json.Unmarshal(data, &feed); err != nil {
+				return nil, err
+			}
+			items = feed.Items
+		}
+		for _, item := range items {
+			headlines = append(headlines, newHeadline(item.ID, item.Title,
+				item.DatePublished, item.Published, item.Date))
+		}
+	default:
+		var feed xmlFeed
+		if err := xml.Unmarshal(data, &feed); err != nil {
+			return nil, fmt.Errorf("not an RSS, Atom or JSON feed: %v", err)
+		}
+		for _, item := range append(feed.Items, feed.Entries...) {
+			id := item.GUID
+			if id == "" {
+				id = item.ID
+			}
+			headlines = append(headlines, newHeadline(id, item.Title,
+				item.PubDate, item.Published, item.Updated))
+		}
+	}
+
+	// Stories without a title can't be matched against anything
+	titled := []Headline{}
+	for _, headline := range headlines {
+		if headline.Title != "" {
+			titled = append(titled, headline)
+		}
+	}
+	return titled, nil
+}
+
+// newHeadline builds a headline from a feed item, using the first date
+// that can be read
+func newHeadline(id, title string, dates ...string) Headline {
+	headline := Headline{ID: strings.TrimSpace(id), Title: strings.TrimSpace(title)}
+	if headline.ID == "" {
+		headline.ID = headline.Title
+	}
+	for _, date := range dates {
+		if published, ok := parseFeedDate(date); ok {
+			headline.Published = published
+			break
+		}
+	}
+	return headline
+}
+
+// Date formats used by RSS, Atom and JSON feeds
+var feedDateFormats = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC3339,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2006-01-02",
+}
+
+// parseFeedDate reads a date in any of the formats feeds commonly use
+func parseFeedDate(date string) (time.Time, bool) {
+	date = strings.TrimSpace(date)
+	for _, format := range feedDateFormats {
+		if parsed, err := time.Parse(format, date); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
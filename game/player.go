@@ -1,15 +1,45 @@
 package game
 
+import "time"
+
 // Player represents the player's stats and inventory
 type Player struct {
-	Money        int
-	FishCaught   []Fish
-	TotalWeight  int
-	TotalValue   int
-	FishingRod   string
-	RodStrength  int
-	Bait         string
-	BaitStrength int
+	Money         int
+	FishCaught    []Fish
+	TotalWeight   int
+	TotalValue    int
+	FishingRod    string
+	RodStrength   int
+	RodWear       int // Durability lost since the rod was bought or repaired
+	Bait          string
+	BaitStrength  int
+	BaitStock     map[string]int // Casts left of each kind of bait
+	ChumStock     map[string]int // Unused chum of each kind
+	Chum          string         // Kind of chum to throw next, "" until one is picked
+	XP            int            // Total experience earned
+	Skills        map[string]int // Learned rank of each skill by ID
+	Stats         CatchStats
+	Achievements  map[string]time.Time // When each unlocked achievement was earned
+	Quests        QuestBoard
+	Aquarium      Aquarium
+	Crafted       map[string]int       // Crafted items by recipe name, apart from bait
+	Pantry        map[string]int       // Cooked dishes waiting to be eaten
+	MapFragments  int                  // Pieces of the next treasure map
+	Boats         []string             // Names of the boats the player owns
+	Boat          string               // Boat being fished from, "" for the shore
+	Expedition    *Expedition          // Boat out on an expedition, if any
+	Lines         []IdleLine           // Extra rods set in holders, apart from the main rod
+	Conservation  int                  // Conservation score earned by releasing fish
+	Released      int                  // Fish released back into the water
+	Licenses      map[string]time.Time // When each fishing license runs out
+	Illegal       []IllegalCatch       // Fish kept against the rules
+	Populations   Ecosystem            // Fish stocks left at each location
+	BossCooldowns map[string]time.Time // When each escaped legendary creature comes back
+	Festivals     map[string][]string  // Festival fish collected, by festival
+	Combo         Combo                // Catches landed in a row
+	BestCombo     int                  // Longest combo ever landed
+	Variants      map[string][]string  // Rare variants collected, by species
+	Farm          []Pond               // Fish-farm ponds, in the order they were built
 }
 
 // NewPlayer creates a new player with default values
@@ -23,6 +53,7 @@ func NewPlayer() Player {
 		RodStrength:  1,
 		Bait:         "Worm",
 		BaitStrength: 1,
+		BaitStock:    map[string]int{"Worm": 10},
 	}
 }
 
@@ -33,8 +64,24 @@ func (p *Player) AddFish(fish Fish) {
 	p.TotalValue += fish.Value
 }
 
-// SellAllFish sells all fish in the player's inventory
-func (p *Player) SellAllFish() int {
+// RemoveFish takes the fish at the given inventory position out of the
+// inventory. An illegal fish stops being flagged once it's gone, wherever it went.
+func (p *Player) RemoveFish(index int) (Fish, bool) {
+	if index < 0 || index >= len(p.FishCaught) {
+		return Fish{}, false
+	}
+
+	fish := p.FishCaught[index]
+	p.FishCaught = append(p.FishCaught[:index], p.FishCaught[index+1:]...)
+	p.TotalWeight -= fish.Weight
+	p.TotalValue -= fish.Value
+	p.forgetIllegal(fish)
+	return fish, true
+}
+
+// SellAllFish sells all fish in the player's inventory, at a price factor
+// set by whatever is going on at the market
+func (p *Player) SellAllFish(priceFactor float64) int {
 	if len(p.FishCaught) == 0 {
 		return 0
 	}
@@ -43,11 +90,14 @@ func (p *Player) SellAllFish() int {
 	for _, fish := range p.FishCaught {
 		totalValue += fish.Value
 	}
+	totalValue = SalePrice(totalValue, priceFactor)
 
 	p.Money += totalValue
+	p.Stats.TotalSold += totalValue
 
-	// Reset fish inventory
+	// Reset fish inventory. Sold fish can't be inspected any more.
 	p.FishCaught = []Fish{}
+	p.Illegal = nil
 	p.TotalWeight = 0
 	p.TotalValue = 0
 
@@ -63,17 +113,151 @@ func (p *Player) BuyRod(rodName string, cost int, strength int) bool {
 	p.Money -= cost
 	p.FishingRod = rodName
 	p.RodStrength = strength
+	p.RodWear = 0
 	return true
 }
 
-// BuyBait allows the player to buy bait
-func (p *Player) BuyBait(baitName string, cost int, strength int) bool {
+// Rod returns the catalog entry for the equipped fishing rod
+func (p *Player) Rod() Rod {
+	return GetRodByName(p.FishingRod)
+}
+
+// RodDurability returns the remaining durability of the equipped rod
+func (p *Player) RodDurability() int {
+	durability := p.Rod().MaxDurability - p.RodWear
+	if durability < 0 {
+		return 0
+	}
+	return durability
+}
+
+// IsRodBroken reports whether the equipped rod is worn out and needs repair
+func (p *Player) IsRodBroken() bool {
+	return p.RodDurability() == 0
+}
+
+// WearRod wears down the equipped rod after a cast, more so for heavy fish
+func (p *Player) WearRod(fishWeight int) {
+	rod := p.Rod()
+	p.RodWear += 1 + fishWeight*10/rod.MaxWeight
+	if p.RodWear > rod.MaxDurability {
+		p.RodWear = rod.MaxDurability
+	}
+}
+
+// LineSnapChance returns the chance that the line snaps while reeling in a fish
+func (p *Player) LineSnapChance(fishWeight int) float64 {
+	rod := p.Rod()
+	if fishWeight <= rod.MaxWeight {
+		return 0
+	}
+
+	// The further over the rating, the more likely the snap
+	chance := float64(fishWeight-rod.MaxWeight) / float64(fishWeight)
+
+	// Worn rods give out more easily
+	chance += 0.2 * float64(p.RodWear) / float64(rod.MaxDurability)
+
+	if chance > 0.95 {
+		chance = 0.95
+	}
+	return chance
+}
+
+// RepairCost returns what it costs to fully repair the equipped rod. The free
+// starter rod is repaired for nothing, so nobody is ever left unable to fish.
+func (p *Player) RepairCost() int {
+	if p.Rod().Cost == 0 {
+		return 0
+	}
+
+	costPerPoint := p.Rod().Cost / 200
+	if costPerPoint < 1 {
+		costPerPoint = 1
+	}
+	return p.RodWear * costPerPoint
+}
+
+// RepairRod restores the equipped rod to full durability
+func (p *Player) RepairRod() bool {
+	cost := p.RepairCost()
+	if p.RodWear == 0 || p.Money < cost {
+		return false
+	}
+
+	p.Money -= cost
+	p.RodWear = 0
+	return true
+}
+
+// BuyBait allows the player to buy a pack of bait, equipping it if the
+// current bait has run out
+func (p *Player) BuyBait(baitName string, cost int, quantity int) bool {
 	if p.Money < cost {
 		return false
 	}
 
 	p.Money -= cost
-	p.Bait = baitName
-	p.BaitStrength = strength
+	if p.BaitStock == nil {
+		p.BaitStock = make(map[string]int)
+	}
+	p.BaitStock[baitName] += quantity
+
+	if p.BaitLeft() == 0 || p.Bait == baitName {
+		p.EquipBait(baitName)
+	}
 	return true
 }
+
+// BuyChum allows the player to buy one portion of chum
+func (p *Player) BuyChum(chumName string, cost int) bool {
+	if p.Money < cost {
+		return false
+	}
+
+	p.Money -= cost
+	if p.ChumStock == nil {
+		p.ChumStock = make(map[string]int)
+	}
+	p.ChumStock[chumName]++
+	return true
+}
+
+// UseChum takes one portion of chum out of the player's stock
+func (p *Player) UseChum(chumName string) bool {
+	if p.ChumStock[chumName] == 0 {
+		return false
+	}
+
+	p.ChumStock[chumName]--
+	return true
+}
+
+// BaitLeft returns how many casts of the equipped bait are left
+func (p *Player) BaitLeft() int {
+	return p.BaitStock[p.Bait]
+}
+
+// EquipBait puts a different kind of bait on the hook
+func (p *Player) EquipBait(baitName string) bool {
+	bait, ok := GetBaitByName(baitName)
+	if !ok || p.BaitStock[baitName] == 0 {
+		return false
+	}
+
+	p.Bait = bait.Name
+	p.BaitStrength = bait.Strength
+	return true
+}
+
+// UseBait uses up one piece of the equipped bait for a cast.
+// It returns false, and a bare hook, when the bait has run out.
+func (p *Player) UseBait() (BaitType, bool) {
+	if p.BaitLeft() == 0 {
+		return BaitType{}, false
+	}
+
+	p.BaitStock[p.Bait]--
+	bait, _ := GetBaitByName(p.Bait)
+	return bait, true
+}
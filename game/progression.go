@@ -0,0 +1,126 @@
+package game
+
+// Skill is a perk in the skill tree, bought with points earned by levelling up
+type Skill struct {
+	ID          string
+	Name        string
+	Description string // What one rank of the skill does
+	MaxRank     int
+	Requires    string // ID of the skill that must be learned first, "" for a root skill
+}
+
+// GetAllSkills returns the skill tree, with each skill listed after its prerequisite
+func GetAllSkills() []Skill {
+	return []Skill{
+		{"quick_bite", "Quick Bite", "Fish bite 8% sooner", 5, ""},
+		{"idle_angler", "Idle Angler", "Catch 20% more fish while away", 5, "quick_bite"},
+		{"clean_waters", "Clean Waters", "Reel in 2% less trash", 3, ""},
+		{"legend_lore", "Legend Lore", "+0.25% chance of legendary creatures", 4, "clean_waters"},
+	}
+}
+
+// GetSkillByID returns the skill with the given ID
+func GetSkillByID(id string) (Skill, bool) {
+	for _, skill := range GetAllSkills() {
+		if skill.ID == id {
+			return skill, true
+		}
+	}
+	return Skill{}, false
+}
+
+// CatchXP returns the experience earned for a catch, scaled by rarity and weight
+func CatchXP(fish Fish) int {
+	if fish.IsTrash {
+		return 1
+	}
+
+	xp := (11-fish.Rarity)*2 + fish.Weight/5
+	if fish.IsLegendary {
+		xp += 100
+	}
+	return xp
+}
+
+// XPForLevel returns the total experience needed to reach a level
+func XPForLevel(level int) int {
+	// Each level takes 50 more XP than the one before
+	return 50 * level * (level - 1) / 2
+}
+
+// LevelForXP returns the level reached with the given total experience
+func LevelForXP(xp int) int {
+	level := 1
+	for xp >= XPForLevel(level+1) {
+		level++
+	}
+	return level
+}
+
+// Level returns the player's current level
+func (p *Player) Level() int {
+	return LevelForXP(p.XP)
+}
+
+// AddXP gives the player experience and returns how many levels were gained
+func (p *Player) AddXP(amount int) int {
+	before := p.Level()
+	p.XP += amount
+	return p.Level() - before
+}
+
+// SkillRank returns how many ranks of a skill the player has learned
+func (p *Player) SkillRank(id string) int {
+	return p.Skills[id]
+}
+
+// SkillPoints returns the number of unspent skill points (one per level gained)
+func (p *Player) SkillPoints() int {
+	spent := 0
+	for _, rank := range p.Skills {
+		spent += rank
+	}
+	return p.Level() - 1 - spent
+}
+
+// CanLearnSkill reports whether the player can put a point into a skill
+func (p *Player) CanLearnSkill(skill Skill) bool {
+	if p.SkillPoints() <= 0 || p.SkillRank(skill.ID) >= skill.MaxRank {
+		return false
+	}
+	return skill.Requires == "" || p.SkillRank(skill.Requires) > 0
+}
+
+// LearnSkill spends a skill point on the skill with the given ID
+func (p *Player) LearnSkill(id string) bool {
+	skill, ok := GetSkillByID(id)
+	if !ok || !p.CanLearnSkill(skill) {
+		return false
+	}
+
+	if p.Skills == nil {
+		p.Skills = make(map[string]int)
+	}
+	p.Skills[id]++
+	return true
+}
+
+// BiteTimeFactor returns the multiplier on waiting time from Quick Bite
+func (p *Player) BiteTimeFactor() float64 {
+	return 1 - 0.08*float64(p.SkillRank("quick_bite"))
+}
+
+// IdleCatchBonus returns the extra idle catch rate from Idle Angler
+func (p *Player) IdleCatchBonus() float64 {
+	return 0.2 * float64(p.SkillRank("idle_angler"))
+}
+
+// TrashReduction returns how much Clean Waters lowers the trash chance
+func (p *Player) TrashReduction() float64 {
+	return 0.02 * float64(p.SkillRank("clean_waters"))
+}
+
+// LegendaryBonus returns the extra legendary chance from Legend Lore
+func (p *Player) LegendaryBonus() float64 {
+	return 0.0025 * float64(p.SkillRank("legend_lore"))
+}
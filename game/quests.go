@@ -0,0 +1,177 @@
+package game
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// Quest is a daily or weekly objective on the quest board
+type Quest struct {
+	Description string
+	Kind        string // "catch_count", "catch_time", "catch_heavy", "catch_species" or "sell_value"
+	Param       string // Time of day or species name, depending on Kind
+	Threshold   int    // Minimum weight in lbs for "catch_heavy"
+	Goal        int    // Catches or money needed to complete the quest
+	Progress    int
+	RewardMoney int
+	RewardXP    int
+	Completed   bool
+}
+
+// QuestBoard holds the current daily and weekly quests
+type QuestBoard struct {
+	DailyKey  string // Date (YYYY-MM-DD) the daily quests were rolled for, same as the daily save files
+	WeeklyKey string // ISO week (YYYY-Www) the weekly quests were rolled for
+	Daily     []Quest
+	Weekly    []Quest
+}
+
+// DailyQuestKey returns the key daily quests are rolled for
+func DailyQuestKey(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+// WeeklyQuestKey returns the key weekly quests are rolled for
+func WeeklyQuestKey(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// GenerateQuests rolls a set of quests from the catalog. The key seeds the
+// roll, so the same day or week always gets the same board.
+func GenerateQuests(key string, weekly bool) []Quest {
+	hash := fnv.New64a()
+	hash.Write([]byte(key))
+	rng := rand.New(rand.NewSource(int64(hash.Sum64())))
+
+	// Weekly quests ask for more and pay more
+	scale := 1
+	if weekly {
+		scale = 5
+	}
+
+	times := []string{"Morning", "Afternoon", "Evening", "Night"}
+	timeOfDay := times[rng.Intn(len(times))]
+
+	// A species to hunt: easy ones for the day, harder ones for the week
+	candidates := []Fish{}
+	for _, fish := range GetAllFish() {
+		if fish.IsLegendary {
+			continue
+		}
+		if (weekly && fish.Rarity <= 4) || (!weekly && fish.Rarity >= 5) || fish.Name == "Treasure Chest" {
+			candidates = append(candidates, fish)
+		}
+	}
+	target := candidates[rng.Intn(len(candidates))]
+
+	heavyWeight := 30 + rng.Intn(5)*10
+	sellGoal := (2 + rng.Intn(4)) * 50
+
+	pool := []Quest{
+		{Description: fmt.Sprintf("Catch %d fish", 10*scale), Kind: "catch_count", Goal: 10 * scale,
+			RewardMoney: 30 * scale, RewardXP: 20 * scale},
+		{Description: fmt.Sprintf("Catch %d %s species", 3*scale, timeOfDay), Kind: "catch_time", Param: timeOfDay, Goal: 3 * scale,
+			RewardMoney: 40 * scale, RewardXP: 30 * scale},
+		{Description: fmt.Sprintf("Land a fish over %d lbs", heavyWeight*scale), Kind: "catch_heavy", Threshold: heavyWeight * scale, Goal: 1,
+			RewardMoney: 60 * scale, RewardXP: 40 * scale},
+		{Description: fmt.Sprintf("Catch %s %s", article(target.Name), target.Name), Kind: "catch_species", Param: target.Name, Goal: 1,
+			RewardMoney: 50 * scale, RewardXP: 30 * scale},
+		{Description: fmt.Sprintf("Sell $%d of fish", sellGoal*scale), Kind: "sell_value", Goal: sellGoal * scale,
+			RewardMoney: 25 * scale, RewardXP: 25 * scale},
+	}
+
+	// Three quests a day, four a week
+	count := 3
+	if weekly {
+		count = 4
+	}
+	rng.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+	return pool[:count]
+}
+
+// article returns "a" or "an" to go in front of a name
+func article(name string) string {
+	if name != "" && strings.ContainsRune("AEIOU", rune(name[0])) {
+		return "an"
+	}
+	return "a"
+}
+
+// RefreshQuests rerolls the daily and weekly quests once their period is over
+func (p *Player) RefreshQuests(now time.Time) {
+	if key := DailyQuestKey(now); p.Quests.DailyKey != key {
+		p.Quests.DailyKey = key
+		p.Quests.Daily = GenerateQuests(key, false)
+	}
+	if key := WeeklyQuestKey(now); p.Quests.WeeklyKey != key {
+		p.Quests.WeeklyKey = key
+		p.Quests.Weekly = GenerateQuests(key, true)
+	}
+}
+
+// QuestCatch advances quests with a landed fish, pays out any that are
+// completed and returns them
+func (p *Player) QuestCatch(fish Fish) []Quest {
+	return p.advanceQuests(func(q Quest) int {
+		switch q.Kind {
+		case "catch_count":
+			if !fish.IsTrash {
+				return 1
+			}
+		case "catch_time":
+			if !fish.IsTrash && fish.PreferredTime == q.Param {
+				return 1
+			}
+		case "catch_heavy":
+			if !fish.IsTrash && fish.Weight > q.Threshold {
+				return 1
+			}
+		case "catch_species":
+			if fish.Name == q.Param {
+				return 1
+			}
+		}
+		return 0
+	})
+}
+
+// QuestSale advances quests with money earned from selling fish, pays out
+// any that are completed and returns them
+func (p *Player) QuestSale(amount int) []Quest {
+	return p.advanceQuests(func(q Quest) int {
+		if q.Kind == "sell_value" {
+			return amount
+		}
+		return 0
+	})
+}
+
+// advanceQuests adds progress to every open quest and pays the rewards of
+// those that reach their goal
+func (p *Player) advanceQuests(progressFor func(Quest) int) []Quest {
+	completed := []Quest{}
+
+	for _, quests := range [][]Quest{p.Quests.Daily, p.Quests.Weekly} {
+		for i := range quests {
+			quest := &quests[i]
+			if quest.Completed {
+				continue
+			}
+
+			quest.Progress += progressFor(*quest)
+			if quest.Progress >= quest.Goal {
+				quest.Progress = quest.Goal
+				quest.Completed = true
+				p.Money += quest.RewardMoney
+				p.AddXP(quest.RewardXP)
+				completed = append(completed, *quest)
+			}
+		}
+	}
+
+	return completed
+}
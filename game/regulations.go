@@ -0,0 +1,231 @@
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// Regulation holds the fishing rules of one location. Locations without a
+// regulation can be fished freely.
+type Regulation struct {
+	Location  string
+	License   string         // License needed to fish here, "" for none
+	MinSizes  map[string]int // Smallest weight of each species that may be kept, in lbs
+	BagLimits map[string]int // Most of each species that may be kept in a day
+	Protected []string       // Species that must always be released
+}
+
+// License lets the player fish where a regulation asks for it, until it expires
+type License struct {
+	Name     string
+	Cost     int
+	Duration time.Duration
+}
+
+// Violation is a rule broken by keeping a fish
+type Violation struct {
+	Rule string
+	Fine int
+}
+
+// IllegalCatch is a fish kept against the rules, waiting to be found by a warden
+type IllegalCatch struct {
+	Fish       Fish
+	Location   string
+	Violations []Violation
+}
+
+// Fines for each kind of violation
+const (
+	unlicensedFine = 100
+	undersizedFine = 50
+	overBagFine    = 75
+	protectedFine  = 250
+)
+
+// GetAllRegulations returns the rules of every regulated location
+func GetAllRegulations() []Regulation {
+	return []Regulation{
+		{
+			Location:  ShoreLocation,
+			License:   "Fishing License",
+			MinSizes:  map[string]int{"Bass": 4, "Pike": 6, "Walleye": 5, "Salmon": 7, "Snook": 11, "Redfish": 16, "Striped Bass": 18},
+			BagLimits: map[string]int{"Trout": 5, "Salmon": 2, "Striped Bass": 2, "Redfish": 1},
+			Protected: []string{"Sturgeon", "Goliath Grouper"},
+		},
+		{
+			Location:  "Offshore",
+			License:   "Fishing License",
+			MinSizes:  map[string]int{"Snapper": 8, "Grouper": 11, "Amberjack": 13, "Mahi-Mahi": 12, "King Mackerel": 22},
+			BagLimits: map[string]int{"Snapper": 3, "Grouper": 2, "Mahi-Mahi": 5},
+			Protected: []string{"Goliath Grouper"},
+		},
+		{
+			Location:  "Deep Sea",
+			License:   "Deep Sea Permit",
+			MinSizes:  map[string]int{"Cod": 9, "Halibut": 12, "Swordfish": 120},
+			BagLimits: map[string]int{"Marlin": 1, "Swordfish": 1, "Bluefin Tuna": 1},
+		},
+		// Nobody patrols the Abyssal Trench
+	}
+}
+
+// GetRegulation returns the rules of a location, if it has any
+func GetRegulation(location string) (Regulation, bool) {
+	for _, regulation := range GetAllRegulations() {
+		if regulation.Location == location {
+			return regulation, true
+		}
+	}
+	return Regulation{}, false
+}
+
+// GetAllLicenses returns every license sold at the shop
+func GetAllLicenses() []License {
+	return []License{
+		{"Fishing License", 40, 7 * 24 * time.Hour},
+		{"Deep Sea Permit", 400, 7 * 24 * time.Hour},
+	}
+}
+
+// GetLicenseByName returns the license with the given name
+func GetLicenseByName(name string) (License, bool) {
+	for _, license := range GetAllLicenses() {
+		if license.Name == name {
+			return license, true
+		}
+	}
+	return License{}, false
+}
+
+// IsProtected reports whether a species must be released at this location
+func (r Regulation) IsProtected(name string) bool {
+	for _, protected := range r.Protected {
+		if protected == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Check returns the rules broken by keeping a fish, given how many of its
+// species have already been kept today, counting this one
+func (r Regulation) Check(fish Fish, keptToday int, licensed bool) []Violation {
+	if fish.IsTrash {
+		return nil
+	}
+
+	violations := []Violation{}
+	if r.License != "" && !licensed {
+		violations = append(violations, Violation{"Fishing without a " + r.License, unlicensedFine})
+	}
+	if r.IsProtected(fish.Name) {
+		violations = append(violations, Violation{fish.Name + " is a protected species", protectedFine})
+	}
+	if minimum, ok := r.MinSizes[fish.Name]; ok && fish.Weight < minimum {
+		violations = append(violations, Violation{fmt.Sprintf("Undersized - %s must be at least %d lbs", fish.Name, minimum), undersizedFine})
+	}
+	if limit, ok := r.BagLimits[fish.Name]; ok && keptToday > limit {
+		violations = append(violations, Violation{fmt.Sprintf("Over the bag limit of %d %s a day", limit, fish.Name), overBagFine})
+	}
+	return violations
+}
+
+// Fine returns the total fine for an illegal catch
+func (c IllegalCatch) Fine() int {
+	total := 0
+	for _, violation := range c.Violations {
+		total += violation.Fine
+	}
+	return total
+}
+
+// HasLicense reports whether the player holds a license that hasn't expired
+func (p *Player) HasLicense(name string, now time.Time) bool {
+	return now.Before(p.Licenses[name])
+}
+
+// BuyLicense buys a license, or renews it for another term if it's still valid
+func (p *Player) BuyLicense(name string, now time.Time) bool {
+	license, ok := GetLicenseByName(name)
+	if !ok || p.Money < license.Cost {
+		return false
+	}
+
+	p.Money -= license.Cost
+	if p.Licenses == nil {
+		p.Licenses = map[string]time.Time{}
+	}
+	start := now
+	if p.HasLicense(name, now) {
+		start = p.Licenses[name]
+	}
+	p.Licenses[name] = start.Add(license.Duration)
+	return true
+}
+
+// FlagIllegal remembers a fish that was kept against the rules
+func (p *Player) FlagIllegal(fish Fish, location string, violations []Violation) {
+	p.Illegal = append(p.Illegal, IllegalCatch{Fish: fish, Location: location, Violations: violations})
+}
+
+// IllegalCatchFor returns the rules broken by keeping a fish, if it was flagged
+func (p *Player) IllegalCatchFor(fish Fish) (IllegalCatch, bool) {
+	for i := len(p.Illegal) - 1; i >= 0; i-- {
+		if p.Illegal[i].Fish == fish {
+			return p.Illegal[i], true
+		}
+	}
+	return IllegalCatch{}, false
+}
+
+// forgetIllegal drops the flag on a fish that has left the inventory
+func (p *Player) forgetIllegal(fish Fish) {
+	for i := len(p.Illegal) - 1; i >= 0; i-- {
+		if p.Illegal[i].Fish == fish {
+			p.Illegal = append(p.Illegal[:i], p.Illegal[i+1:]...)
+			return
+		}
+	}
+}
+
+// IllegalKept returns the flagged fish that are still in the inventory
+func (p *Player) IllegalKept() []IllegalCatch {
+	kept := []IllegalCatch{}
+	for _, illegal := range p.Illegal {
+		for _, fish := range p.FishCaught {
+			if fish == illegal.Fish {
+				kept = append(kept, illegal)
+				break
+			}
+		}
+	}
+	return kept
+}
+
+// Inspect is a warden checking the inventory. Every illegal fish still in it
+// is confiscated and fined, up to all the money the player has.
+// It returns the fine and the confiscated catches.
+func (p *Player) Inspect() (int, []IllegalCatch) {
+	fine := 0
+	confiscated := []IllegalCatch{}
+
+	// Confiscating a fish drops its flag, so go through a copy of them
+	for _, illegal := range append([]IllegalCatch{}, p.Illegal...) {
+		for i := len(p.FishCaught) - 1; i >= 0; i-- {
+			if p.FishCaught[i] == illegal.Fish {
+				p.RemoveFish(i)
+				fine += illegal.Fine()
+				confiscated = append(confiscated, illegal)
+				break
+			}
+		}
+	}
+	p.Illegal = nil
+
+	if fine > p.Money {
+		fine = p.Money
+	}
+	p.Money -= fine
+	return fine, confiscated
+}
@@ -0,0 +1,80 @@
+package game
+
+import "time"
+
+// CatchStats tracks lifetime fishing statistics
+type CatchStats struct {
+	TotalCaught     int                      // Fish landed, not counting trash
+	LegendaryCaught int                      // Legendary creatures landed
+	TotalSold       int                      // Money earned from selling fish
+	Species         map[string]SpeciesRecord // Records for each species or trash item landed
+	Day             string                   // Date (YYYY-MM-DD) that CaughtToday counts
+	CaughtToday     int                      // Fish landed on Day, not counting trash
+}
+
+// SpeciesRecord holds the player's lifetime record for one species
+type SpeciesRecord struct {
+	TimesCaught int
+	FirstCaught time.Time
+	Heaviest    int // Weight in lbs of the heaviest one landed
+}
+
+// RecordCatch adds a landed fish to the player's lifetime statistics
+func (p *Player) RecordCatch(fish Fish, when time.Time) {
+	stats := &p.Stats
+	if stats.Species == nil {
+		stats.Species = make(map[string]SpeciesRecord)
+	}
+
+	record := stats.Species[fish.Name]
+	if record.TimesCaught == 0 {
+		record.FirstCaught = when
+	}
+	record.TimesCaught++
+	if fish.Weight > record.Heaviest {
+		record.Heaviest = fish.Weight
+	}
+	stats.Species[fish.Name] = record
+
+	if fish.IsTrash {
+		return
+	}
+
+	day := when.Format("2006-01-02")
+	if stats.Day != day {
+		stats.Day = day
+		stats.CaughtToday = 0
+	}
+	stats.TotalCaught++
+	stats.CaughtToday++
+	if fish.IsLegendary {
+		stats.LegendaryCaught++
+	}
+}
+
+// HasRecords reports whether every species has a full record. Saves from
+// before the Fishdex only counted how many of each species were landed.
+func (s CatchStats) HasRecords() bool {
+	for _, record := range s.Species {
+		if record.FirstCaught.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// HasCaught reports whether the player has ever landed the given species
+func (p *Player) HasCaught(name string) bool {
+	return p.Stats.Species[name].TimesCaught > 0
+}
+
+// CountDiscovered returns how many entries of a catalog list the player has landed
+func (p *Player) CountDiscovered(fishList []Fish) int {
+	count := 0
+	for _, fish := range fishList {
+		if p.HasCaught(fish.Name) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,223 @@
+package game
+
+import (
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// Rival is a simulated angler who competes in tournaments
+type Rival struct {
+	Name      string
+	CatchRate float64 // Average catches per minute
+	Skill     int     // 0-10, how much they favour rarer, heavier fish
+}
+
+// GetAllRivals returns the anglers who enter tournaments
+func GetAllRivals() []Rival {
+	return []Rival{
+		{"Speedy Sue", 1.2, 2},
+		{"Old Man Jenkins", 0.7, 6},
+		{"Captain Hook", 0.8, 8},
+		{"Lucky Luke", 0.5, 10},
+	}
+}
+
+// TournamentEntrant is one angler's standing in a tournament
+type TournamentEntrant struct {
+	Name        string
+	IsPlayer    bool
+	Rival       Rival // Zero for the player
+	Catches     int
+	TotalWeight int
+	TotalValue  int
+	BestCatch   string    // Name of the heaviest fish landed
+	ScoredAt    time.Time // When the current score was reached, which breaks ties
+	bestWeight  int
+}
+
+// Score returns the entrant's score under the tournament's scoring rule
+func (e TournamentEntrant) Score(scoring string) int {
+	if scoring == "value" {
+		return e.TotalValue
+	}
+	return e.TotalWeight
+}
+
+// addCatch counts a fish landed at the given time towards the entrant's score
+func (e *TournamentEntrant) addCatch(fish Fish, when time.Time) {
+	e.Catches++
+	e.TotalWeight += fish.Weight
+	e.TotalValue += fish.Value
+	if fish.Weight > e.bestWeight {
+		e.bestWeight = fish.Weight
+		e.BestCatch = fish.Name
+	}
+	e.ScoredAt = when
+}
+
+// Tournament is a timed fishing competition against rival anglers
+type Tournament struct {
+	Scoring    string // "weight" or "value"
+	Started    time.Time
+	Duration   time.Duration
+	Entrants   []TournamentEntrant
+	speed      float64   // Multiplier on rival catch rates
+	lastUpdate time.Time // When the rivals were last simulated
+}
+
+// Trophy records a top-three tournament finish
+type Trophy struct {
+	Name    string // e.g. "Gold Cup"
+	Place   int
+	Scoring string
+	Score   int
+	Prize   int
+	Date    time.Time
+}
+
+// Prize money for first, second and third place
+var tournamentPrizes = []int{500, 250, 100}
+
+// Trophy names for first, second and third place
+var trophyNames = []string{"Gold Cup", "Silver Cup", "Bronze Cup"}
+
+// NewTournament starts a tournament against every rival. Speed scales the
+// rivals' catch rates to match how fast the player's casts resolve.
+func NewTournament(scoring string, duration time.Duration, speed float64, now time.Time) *Tournament {
+	t := &Tournament{
+		Scoring:    scoring,
+		Started:    now,
+		Duration:   duration,
+		speed:      speed,
+		lastUpdate: now,
+	}
+
+	t.Entrants = append(t.Entrants, TournamentEntrant{Name: "You", IsPlayer: true})
+	for _, rival := range GetAllRivals() {
+		t.Entrants = append(t.Entrants, TournamentEntrant{Name: rival.Name, Rival: rival})
+	}
+	return t
+}
+
+// Remaining returns how much time is left in the tournament
+func (t *Tournament) Remaining(now time.Time) time.Duration {
+	remaining := t.Started.Add(t.Duration).Sub(now)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// IsOver reports whether the tournament time has run out
+func (t *Tournament) IsOver(now time.Time) bool {
+	return t.Remaining(now) == 0
+}
+
+// AddPlayerCatch counts a fish the player landed during the tournament
+func (t *Tournament) AddPlayerCatch(fish Fish, now time.Time) {
+	for i := range t.Entrants {
+		if t.Entrants[i].IsPlayer {
+			t.Entrants[i].addCatch(fish, now)
+			return
+		}
+	}
+}
+
+// Advance simulates the rivals' fishing up to now (or the end of the tournament)
+func (t *Tournament) Advance(now time.Time) {
+	end := t.Started.Add(t.Duration)
+	if now.After(end) {
+		now = end
+	}
+
+	// Roll each elapsed second separately so catches stay independent
+	for ; t.lastUpdate.Before(now); t.lastUpdate = t.lastUpdate.Add(time.Second) {
+		for i := range t.Entrants {
+			entrant := &t.Entrants[i]
+			if entrant.IsPlayer {
+				continue
+			}
+			if rand.Float64() < entrant.Rival.CatchRate*t.speed/60 {
+				entrant.addCatch(rivalCatch(entrant.Rival), t.lastUpdate)
+			}
+		}
+	}
+}
+
+// rivalCatch picks a fish for a rival, with skilled rivals landing rarer fish
+func rivalCatch(rival Rival) Fish {
+	regularFish := GetRegularFish()
+
+	totalWeight := 0
+	weights := make([]int, len(regularFish))
+	for i, fish := range regularFish {
+		weights[i] = fish.Rarity*10 + (11-fish.Rarity)*rival.Skill
+		totalWeight += weights[i]
+	}
+
+	randomNum := rand.Intn(totalWeight)
+	for i, fish := range regularFish {
+		randomNum -= weights[i]
+		if randomNum < 0 {
+			return RollCatch(fish)
+		}
+	}
+	return RollCatch(regularFish[0])
+}
+
+// Standings returns the entrants ranked by score, best first. Whoever reached
+// a tied score first ranks higher.
+func (t *Tournament) Standings() []TournamentEntrant {
+	standings := append([]TournamentEntrant{}, t.Entrants...)
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Score(t.Scoring) != b.Score(t.Scoring) {
+			return a.Score(t.Scoring) > b.Score(t.Scoring)
+		}
+		return a.ScoredAt.Before(b.ScoredAt)
+	})
+	return standings
+}
+
+// PlayerPlace returns the player's current position, starting at 1
+func (t *Tournament) PlayerPlace() int {
+	for i, entrant := range t.Standings() {
+		if entrant.IsPlayer {
+			return i + 1
+		}
+	}
+	return len(t.Entrants)
+}
+
+// Finish ranks the final standings and returns the player's trophy, if they
+// placed in the top three
+func (t *Tournament) Finish(now time.Time) (Trophy, bool) {
+	t.Advance(now)
+
+	place := t.PlayerPlace()
+	if place > len(tournamentPrizes) {
+		return Trophy{}, false
+	}
+
+	score := 0
+	for _, entrant := range t.Entrants {
+		if entrant.IsPlayer {
+			score = entrant.Score(t.Scoring)
+		}
+	}
+
+	// No prizes for an empty bag
+	if score == 0 {
+		return Trophy{}, false
+	}
+
+	return Trophy{
+		Name:    trophyNames[place-1],
+		Place:   place,
+		Scoring: t.Scoring,
+		Score:   score,
+		Prize:   tournamentPrizes[place-1],
+		Date:    now,
+	}, true
+}
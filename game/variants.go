@@ -0,0 +1,98 @@
+package game
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Variant is a rare form any species can turn up in
+type Variant struct {
+	Name        string
+	Icon        string
+	Color       string  // Color the fish comes in, "" to keep its own
+	Chance      float64 // Chance of any catch being this variant
+	ValueFactor float64 // Multiplier for the catch's value
+	SizeFactor  float64 // Multiplier for the catch's weight, 0 for no change
+	Message     string  // Shown when it's caught, %s is the species
+}
+
+// GetAllVariants returns every variant, commonest first
+func GetAllVariants() []Variant {
+	return []Variant{
+		{"Albino", "◌", "Albino", 0.006, 3, 0, "An ALBINO %s! Its pale scales shine like pearl."},
+		{"Melanistic", "●", "Melanistic", 0.006, 3, 0, "A MELANISTIC %s! Jet black from nose to tail."},
+		{"Giant", "▲", "", 0.004, 2, 2.5, "A GIANT %s! It dwarfs the rest of its kind!"},
+		{"Golden", "★", "Golden", 0.002, 10, 0, "A GOLDEN %s! It glitters like treasure in the sun!"},
+	}
+}
+
+// rollVariant turns a freshly caught fish into a rare variant now and then
+func rollVariant(fish Fish) Fish {
+	if fish.IsTrash {
+		return fish
+	}
+
+	roll := rand.Float64()
+	for _, variant := range GetAllVariants() {
+		if roll >= variant.Chance {
+			roll -= variant.Chance
+			continue
+		}
+
+		if variant.Color != "" {
+			fish.Color = variant.Color
+		}
+		if variant.SizeFactor > 0 {
+			fish.Weight = int(float64(fish.Weight)*variant.SizeFactor + 0.5)
+		}
+		fish.Value = int(float64(fish.Value) * variant.ValueFactor)
+		fish.CatchMsg = fmt.Sprintf(variant.Message, fish.Name)
+		fish.Catch.Variant = variant.Name
+		return fish
+	}
+	return fish
+}
+
+// VariantOf returns the variant a caught fish is, if it's one
+func VariantOf(fish Fish) (Variant, bool) {
+	for _, variant := range GetAllVariants() {
+		if fish.Catch.Variant == variant.Name {
+			return variant, true
+		}
+	}
+	return Variant{}, false
+}
+
+// HasVariant reports whether the player has caught a species in a variant
+func (p *Player) HasVariant(species, variant string) bool {
+	for _, caught := range p.Variants[species] {
+		if caught == variant {
+			return true
+		}
+	}
+	return false
+}
+
+// CollectVariant adds a caught variant to the collection. Returns the
+// variant if it's the first of its kind for the species.
+func (p *Player) CollectVariant(fish Fish) (Variant, bool) {
+	variant, ok := VariantOf(fish)
+	if !ok || p.HasVariant(fish.Name, variant.Name) {
+		return Variant{}, false
+	}
+
+	if p.Variants == nil {
+		p.Variants = map[string][]string{}
+	}
+	p.Variants[fish.Name] = append(p.Variants[fish.Name], variant.Name)
+	return variant, true
+}
+
+// CountVariants returns how many variants have been collected among a list of species
+func (p *Player) CountVariants(fishList []Fish) int {
+	count := 0
+	for _, fish := range fishList {
+		count += len(p.Variants[fish.Name])
+	}
+	return count
+}